@@ -0,0 +1,70 @@
+package zabbix
+
+type (
+	// AlertType kind of the alert
+	// see "alerttype" in https://www.zabbix.com/documentation/current/en/manual/api/reference/alert/object
+	AlertType int
+
+	// AlertStatus delivery status of the alert
+	AlertStatus int
+)
+
+const (
+	// AlertMessage message alert
+	AlertMessage AlertType = 0
+	// AlertCommand remote command alert
+	AlertCommand AlertType = 1
+)
+
+const (
+	// Message alert statuses
+
+	// AlertNotSent message not sent
+	AlertNotSent AlertStatus = 0
+	// AlertSent message sent
+	AlertSent AlertStatus = 1
+	// AlertFailed failed after a number of retries
+	AlertFailed AlertStatus = 2
+	// AlertNew new alert, not yet processed by the alert manager
+	AlertNew AlertStatus = 3
+)
+
+// Alert represent Zabbix alert object, a notification or remote command
+// that has been generated by an action.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/alert/object
+type Alert struct {
+	AlertID       string      `json:"alertid"`
+	ActionID      string      `json:"actionid"`
+	AlertType     AlertType   `json:"alerttype,string"`
+	Clock         int64       `json:"clock,string"`
+	Error         string      `json:"error"`
+	EscStep       int         `json:"esc_step,string"`
+	EventID       string      `json:"eventid"`
+	ProblemID     string      `json:"p_eventid,omitempty"`
+	AcknowledgeID string      `json:"acknowledgeid,omitempty"`
+	MediaTypeID   string      `json:"mediatypeid"`
+	Message       string      `json:"message"`
+	Retries       int         `json:"retries,string"`
+	SendTo        string      `json:"sendto"`
+	Status        AlertStatus `json:"status,string"`
+	Subject       string      `json:"subject"`
+	UserID        string      `json:"userid"`
+}
+
+// Alerts is an array of Alert
+type Alerts []Alert
+
+// AlertsGet Wrapper for alert.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/alert/get
+func (api *API) AlertsGet(params Params) (res Alerts, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("alert.get", params, &res)
+	return
+}
+
+// AlertsGetByEventIDs Gets alerts generated for the given events, oldest first.
+func (api *API) AlertsGetByEventIDs(ids []string) (res Alerts, err error) {
+	return api.AlertsGet(Params{"eventids": ids, "sortfield": []string{"clock", "alertid"}})
+}
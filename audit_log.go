@@ -0,0 +1,290 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+type (
+	// AuditAction action recorded in the audit log
+	// see "action" in https://www.zabbix.com/documentation/current/en/manual/api/reference/auditlog/object
+	AuditAction int
+
+	// AuditResourceType type of the resource changed by the action
+	AuditResourceType int
+)
+
+const (
+	AuditAdd           AuditAction = 0
+	AuditUpdate        AuditAction = 1
+	AuditDelete        AuditAction = 2
+	AuditLogout        AuditAction = 4
+	AuditExecute       AuditAction = 7
+	AuditLogin         AuditAction = 8
+	AuditFailedLogin   AuditAction = 9
+	AuditHistoryClear  AuditAction = 10
+	AuditConfigRefresh AuditAction = 11
+	// AuditPush history.push, since 7.0
+	AuditPush AuditAction = 12
+)
+
+const (
+	AuditResourceUser              AuditResourceType = 0
+	AuditResourceMediaType         AuditResourceType = 3
+	AuditResourceHost              AuditResourceType = 4
+	AuditResourceAction            AuditResourceType = 5
+	AuditResourceGraph             AuditResourceType = 6
+	AuditResourceUserGroup         AuditResourceType = 11
+	AuditResourceTrigger           AuditResourceType = 13
+	AuditResourceHostGroup         AuditResourceType = 14
+	AuditResourceItem              AuditResourceType = 15
+	AuditResourceImage             AuditResourceType = 16
+	AuditResourceValueMap          AuditResourceType = 17
+	AuditResourceService           AuditResourceType = 18
+	AuditResourceMap               AuditResourceType = 19
+	AuditResourceWebScenario       AuditResourceType = 22
+	AuditResourceDiscoveryRule     AuditResourceType = 23
+	AuditResourceScript            AuditResourceType = 25
+	AuditResourceProxy             AuditResourceType = 26
+	AuditResourceMaintenance       AuditResourceType = 27
+	AuditResourceRegexp            AuditResourceType = 28
+	AuditResourceMacro             AuditResourceType = 29
+	AuditResourceTemplate          AuditResourceType = 30
+	AuditResourceTriggerPrototype  AuditResourceType = 31
+	AuditResourceIconMap           AuditResourceType = 32
+	AuditResourceDashboard         AuditResourceType = 33
+	AuditResourceCorrelation       AuditResourceType = 34
+	AuditResourceGraphPrototype    AuditResourceType = 35
+	AuditResourceItemPrototype     AuditResourceType = 36
+	AuditResourceHostPrototype     AuditResourceType = 37
+	AuditResourceAutoregistration  AuditResourceType = 38
+	AuditResourceModule            AuditResourceType = 39
+	AuditResourceSettings          AuditResourceType = 40
+	AuditResourceHousekeeping      AuditResourceType = 41
+	AuditResourceAuthentication    AuditResourceType = 42
+	AuditResourceTemplateDashboard AuditResourceType = 43
+	AuditResourceRole              AuditResourceType = 44
+	AuditResourceToken             AuditResourceType = 45
+	AuditResourceReport            AuditResourceType = 46
+	AuditResourceHANode            AuditResourceType = 47
+	AuditResourceSLA               AuditResourceType = 48
+	AuditResourceUserDirectory     AuditResourceType = 49
+	AuditResourceTemplateGroup     AuditResourceType = 50
+	AuditResourceConnector         AuditResourceType = 51
+	AuditResourceLLDRule           AuditResourceType = 52
+	AuditResourceHistory           AuditResourceType = 53
+	AuditResourceMFA               AuditResourceType = 54
+	AuditResourceProxyGroup        AuditResourceType = 55
+)
+
+// AuditLogChange a field change of the audit log entry details
+type AuditLogChange struct {
+	// Field path of the field, e.g. "host.name" or "host.groups[12]"
+	Field string
+	// Action "add", "update", "delete", "attach" or "detach"
+	Action string
+	// New value, empty if not recorded
+	New string
+	// Old value, set for updates only
+	Old string
+}
+
+// AuditLogChanges is an array of AuditLogChange
+type AuditLogChanges []AuditLogChange
+
+// AuditLogEntry represent Zabbix audit log object, available since 5.4
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/auditlog/object
+type AuditLogEntry struct {
+	AuditID      string            `json:"auditid"`
+	UserID       string            `json:"userid"`
+	Username     string            `json:"username"`
+	Clock        int64             `json:"clock,string"`
+	IP           string            `json:"ip"`
+	Action       AuditAction       `json:"action,string"`
+	ResourceType AuditResourceType `json:"resourcetype,string"`
+	ResourceID   string            `json:"resourceid"`
+	ResourceCUID string            `json:"resource_cuid"`
+	ResourceName string            `json:"resourcename"`
+	RecordSetID  string            `json:"recordsetid"`
+
+	RawDetails string          `json:"details"`
+	Details    AuditLogChanges `json:"-"`
+}
+
+// AuditLogEntries is an array of AuditLogEntry
+type AuditLogEntries []AuditLogEntry
+
+// Time returns time of the entry.
+func (e AuditLogEntry) Time() time.Time {
+	return time.Unix(e.Clock, 0)
+}
+
+func auditValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// parseAuditDetails parses details of the entry, a JSON object of field paths
+// to ["add"|"update"|"delete"|"attach"|"detach", new value, old value].
+func parseAuditDetails(details string) (res AuditLogChanges, err error) {
+	if details == "" {
+		return
+	}
+
+	var fields map[string][]interface{}
+	if err = json.Unmarshal([]byte(details), &fields); err != nil {
+		return
+	}
+
+	for field, change := range fields {
+		c := AuditLogChange{Field: field}
+		if len(change) > 0 {
+			c.Action = auditValue(change[0])
+		}
+		if len(change) > 1 {
+			c.New = auditValue(change[1])
+		}
+		if len(change) > 2 {
+			c.Old = auditValue(change[2])
+		}
+		res = append(res, c)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Field < res[j].Field })
+	return
+}
+
+// AuditLogGet Wrapper for auditlog.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/auditlog/get
+func (api *API) AuditLogGet(params Params) (res AuditLogEntries, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("auditlog.get", params, &res)
+
+	for i := range res {
+		details, e := parseAuditDetails(res[i].RawDetails)
+		if e != nil {
+			// unexpected shape, keep the raw value only
+			api.printf("got error during audit details unmarshal %s", e)
+			continue
+		}
+		res[i].Details = details
+	}
+	return
+}
+
+// AuditLogCursor position of AuditLogFollower in the audit log
+type AuditLogCursor struct {
+	// Clock of the last emitted entries
+	Clock int64
+	// AuditIDs of the entries emitted at Clock, audit IDs are not ordered
+	AuditIDs []string
+}
+
+// AuditLogFollower polls the audit log for entries newer than its cursor.
+// Cursor may be saved to resume following later, it must not be read while Follow runs.
+type AuditLogFollower struct {
+	Cursor AuditLogCursor
+	// Interval between polls, DefaultAuditLogInterval if not positive
+	Interval time.Duration
+	// Params additional auditlog.get parameters, e.g. filter by resource type
+	Params Params
+
+	api *API
+}
+
+// DefaultAuditLogInterval interval between polls of AuditLogFollower with no Interval set
+const DefaultAuditLogInterval = 10 * time.Second
+
+// NewAuditLogFollower returns follower starting right after the cursor.
+func (api *API) NewAuditLogFollower(cursor AuditLogCursor, interval time.Duration) *AuditLogFollower {
+	return &AuditLogFollower{Cursor: cursor, Interval: interval, api: api}
+}
+
+// newEntries gets entries newer than the cursor in chronological order.
+func (f *AuditLogFollower) newEntries() (res AuditLogEntries, err error) {
+	params := Params{}
+	for k, v := range f.Params {
+		params[k] = v
+	}
+	params["time_from"] = f.Cursor.Clock
+	params["sortfield"] = "clock"
+	params["sortorder"] = "ASC"
+
+	entries, err := f.api.AuditLogGet(params)
+	if err != nil {
+		return
+	}
+
+	seen := make(map[string]bool, len(f.Cursor.AuditIDs))
+	for _, id := range f.Cursor.AuditIDs {
+		seen[id] = true
+	}
+	for _, e := range entries {
+		if e.Clock < f.Cursor.Clock || (e.Clock == f.Cursor.Clock && seen[e.AuditID]) {
+			continue
+		}
+		res = append(res, e)
+	}
+	return
+}
+
+// advance moves the cursor past the entry.
+func (f *AuditLogFollower) advance(e AuditLogEntry) {
+	if e.Clock > f.Cursor.Clock {
+		f.Cursor.Clock = e.Clock
+		f.Cursor.AuditIDs = nil
+	}
+	f.Cursor.AuditIDs = append(f.Cursor.AuditIDs, e.AuditID)
+}
+
+// Poll gets entries newer than the cursor in chronological order and advances the cursor past them.
+func (f *AuditLogFollower) Poll() (res AuditLogEntries, err error) {
+	res, err = f.newEntries()
+	for _, e := range res {
+		f.advance(e)
+	}
+	return
+}
+
+// Follow polls the audit log every Interval and sends new entries to the channel
+// until the context is done or a call fails. The cursor is advanced past sent entries only,
+// the channel is not closed.
+func (f *AuditLogFollower) Follow(ctx context.Context, entries chan<- AuditLogEntry) error {
+	interval := f.Interval
+	if interval <= 0 {
+		interval = DefaultAuditLogInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		res, err := f.newEntries()
+		if err != nil {
+			return err
+		}
+		for _, e := range res {
+			select {
+			case entries <- e:
+				f.advance(e)
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package zabbix_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestAuditLogFollower(t *testing.T) {
+	api := getAPI(t)
+
+	follower := api.NewAuditLogFollower(zapi.AuditLogCursor{Clock: time.Now().Unix()}, time.Second)
+	follower.Params = zapi.Params{"filter": zapi.Params{"resourcetype": zapi.AuditResourceHostGroup}}
+	if _, err := follower.Poll(); err != nil {
+		t.Fatal(err)
+	}
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	entries, err := follower.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, e := range entries {
+		if e.Action == zapi.AuditAdd && e.ResourceID == group.GroupID {
+			found = true
+			if len(e.Details) == 0 {
+				t.Errorf("Details are not parsed: %#v", e)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("Host group creation is not found: %#v", entries)
+	}
+
+	entries, err = follower.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("Entries are emitted twice: %#v", entries)
+	}
+}
+
+func TestAuditLogFollowerDefaultInterval(t *testing.T) {
+	api := getAPI(t)
+
+	// zero interval falls back to the default instead of panicking
+	follower := api.NewAuditLogFollower(zapi.AuditLogCursor{Clock: time.Now().Unix()}, 0)
+	follower.Params = zapi.Params{"filter": zapi.Params{"resourcetype": zapi.AuditResourceHostGroup}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := follower.Follow(ctx, make(chan zapi.AuditLogEntry, 100))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+package zabbix
+
+// Authentication represent Zabbix authentication object, available since 5.2.
+// Empty fields are not updated unless listed in AuthenticationUpdate, flags are "0" disabled and "1" enabled.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/authentication/object
+type Authentication struct {
+	// AuthenticationType default authentication, "0" internal and "1" LDAP
+	AuthenticationType string `json:"authentication_type,omitempty"`
+	HTTPAuthEnabled    string `json:"http_auth_enabled,omitempty"`
+	HTTPLoginForm      string `json:"http_login_form,omitempty"`
+	HTTPStripDomains   string `json:"http_strip_domains,omitempty"`
+	HTTPCaseSensitive  string `json:"http_case_sensitive,omitempty"`
+	LDAPCaseSensitive  string `json:"ldap_case_sensitive,omitempty"`
+	SAMLAuthEnabled    string `json:"saml_auth_enabled,omitempty"`
+	SAMLCaseSensitive  string `json:"saml_case_sensitive,omitempty"`
+
+	// before 6.4, renamed to LDAPAuthEnabled
+	LDAPConfigured string `json:"ldap_configured,omitempty"`
+
+	// before 6.2, moved to user directories
+	LDAPHost            string `json:"ldap_host,omitempty"`
+	LDAPPort            string `json:"ldap_port,omitempty"`
+	LDAPBaseDN          string `json:"ldap_base_dn,omitempty"`
+	LDAPSearchAttribute string `json:"ldap_search_attribute,omitempty"`
+	LDAPBindDN          string `json:"ldap_bind_dn,omitempty"`
+	LDAPBindPassword    string `json:"ldap_bind_password,omitempty"`
+
+	// before 6.4, moved to user directories
+	SAMLIdpEntityID         string `json:"saml_idp_entityid,omitempty"`
+	SAMLSSOURL              string `json:"saml_sso_url,omitempty"`
+	SAMLSLOURL              string `json:"saml_slo_url,omitempty"`
+	SAMLUsernameAttribute   string `json:"saml_username_attribute,omitempty"`
+	SAMLSPEntityID          string `json:"saml_sp_entityid,omitempty"`
+	SAMLNameIDFormat        string `json:"saml_nameid_format,omitempty"`
+	SAMLSignMessages        string `json:"saml_sign_messages,omitempty"`
+	SAMLSignAssertions      string `json:"saml_sign_assertions,omitempty"`
+	SAMLSignAuthnRequests   string `json:"saml_sign_authn_requests,omitempty"`
+	SAMLSignLogoutRequests  string `json:"saml_sign_logout_requests,omitempty"`
+	SAMLSignLogoutResponses string `json:"saml_sign_logout_responses,omitempty"`
+	SAMLEncryptNameID       string `json:"saml_encrypt_nameid,omitempty"`
+	SAMLEncryptAssertions   string `json:"saml_encrypt_assertions,omitempty"`
+
+	// since 5.4
+	PasswdMinLength  string `json:"passwd_min_length,omitempty"`
+	PasswdCheckRules string `json:"passwd_check_rules,omitempty"`
+
+	// since 6.2, default LDAP user directory
+	LDAPUserDirectoryID string `json:"ldap_userdirectoryid,omitempty"`
+
+	// since 6.4
+	LDAPAuthEnabled      string `json:"ldap_auth_enabled,omitempty"`
+	LDAPJITStatus        string `json:"ldap_jit_status,omitempty"`
+	SAMLJITStatus        string `json:"saml_jit_status,omitempty"`
+	JITProvisionInterval string `json:"jit_provision_interval,omitempty"`
+	DisabledUserGroupID  string `json:"disabled_usrgrpid,omitempty"`
+
+	// since 7.0, default MFA method
+	MFAStatus string `json:"mfa_status,omitempty"`
+	MFAID     string `json:"mfaid,omitempty"`
+}
+
+var authenticationVersions = map[string]fieldVersion{
+	"ldap_configured":            {until: 60400},
+	"ldap_host":                  {until: 60200},
+	"ldap_port":                  {until: 60200},
+	"ldap_base_dn":               {until: 60200},
+	"ldap_search_attribute":      {until: 60200},
+	"ldap_bind_dn":               {until: 60200},
+	"ldap_bind_password":         {until: 60200},
+	"saml_idp_entityid":          {until: 60400},
+	"saml_sso_url":               {until: 60400},
+	"saml_slo_url":               {until: 60400},
+	"saml_username_attribute":    {until: 60400},
+	"saml_sp_entityid":           {until: 60400},
+	"saml_nameid_format":         {until: 60400},
+	"saml_sign_messages":         {until: 60400},
+	"saml_sign_assertions":       {until: 60400},
+	"saml_sign_authn_requests":   {until: 60400},
+	"saml_sign_logout_requests":  {until: 60400},
+	"saml_sign_logout_responses": {until: 60400},
+	"saml_encrypt_nameid":        {until: 60400},
+	"saml_encrypt_assertions":    {until: 60400},
+	"passwd_min_length":          {since: 50400},
+	"passwd_check_rules":         {since: 50400},
+	"ldap_userdirectoryid":       {since: 60200},
+	"ldap_auth_enabled":          {since: 60400},
+	"ldap_jit_status":            {since: 60400},
+	"saml_jit_status":            {since: 60400},
+	"jit_provision_interval":     {since: 60400},
+	"disabled_usrgrpid":          {since: 60400},
+	"mfa_status":                 {since: 70000},
+	"mfaid":                      {since: 70000},
+}
+
+// AuthenticationGet Wrapper for authentication.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/authentication/get
+func (api *API) AuthenticationGet() (res *Authentication, err error) {
+	res = &Authentication{}
+	err = api.CallWithErrorParse("authentication.get", Params{"output": "extend"}, res)
+	return
+}
+
+// AuthenticationUpdate Wrapper for authentication.update
+// Empty fields and fields unsupported by the server version are not sent.
+// If JSON names of fields are given, only these fields are sent, so that they can be set to "".
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/authentication/update
+func (api *API) AuthenticationUpdate(authentication Authentication, fields ...string) (err error) {
+	params, err := api.versionedParams(authentication, authenticationVersions, fields)
+	if err != nil {
+		return
+	}
+	_, err = api.CallWithError("authentication.update", params)
+	return
+}
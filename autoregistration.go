@@ -0,0 +1,34 @@
+package zabbix
+
+// AutoregistrationTLSAccept connections accepted from active agents, flags may be combined
+// see "tls_accept" in https://www.zabbix.com/documentation/current/en/manual/api/reference/autoregistration/object
+type AutoregistrationTLSAccept int
+
+const (
+	AutoregistrationNoEncryption AutoregistrationTLSAccept = 1
+	AutoregistrationPSK          AutoregistrationTLSAccept = 2
+)
+
+// Autoregistration represent Zabbix autoregistration object, available since 4.4
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/autoregistration/object
+type Autoregistration struct {
+	TLSAccept AutoregistrationTLSAccept `json:"tls_accept,omitempty,string"`
+	// write only, both must be set to enable PSK
+	TLSPSKIdentity string `json:"tls_psk_identity,omitempty"`
+	TLSPSK         string `json:"tls_psk,omitempty"`
+}
+
+// AutoregistrationGet Wrapper for autoregistration.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/autoregistration/get
+func (api *API) AutoregistrationGet() (res *Autoregistration, err error) {
+	res = &Autoregistration{}
+	err = api.CallWithErrorParse("autoregistration.get", Params{"output": "extend"}, res)
+	return
+}
+
+// AutoregistrationUpdate Wrapper for autoregistration.update
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/autoregistration/update
+func (api *API) AutoregistrationUpdate(autoregistration Autoregistration) (err error) {
+	_, err = api.CallWithError("autoregistration.update", autoregistration)
+	return
+}
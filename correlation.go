@@ -0,0 +1,202 @@
+package zabbix
+
+type (
+	// CorrelationEvalType how the conditions of a correlation filter are combined
+	// see "evaltype" in https://www.zabbix.com/documentation/current/en/manual/api/reference/correlation/object#correlation-filter
+	CorrelationEvalType int
+
+	// CorrelationConditionType type of a correlation condition
+	CorrelationConditionType int
+
+	// CorrelationOperator operator of a host group or tag value condition
+	CorrelationOperator int
+
+	// CorrelationOperationType action taken when the correlation matches
+	CorrelationOperationType int
+)
+
+const (
+	CorrelationAndOr  CorrelationEvalType = 0
+	CorrelationAnd    CorrelationEvalType = 1
+	CorrelationOr     CorrelationEvalType = 2
+	CorrelationCustom CorrelationEvalType = 3
+)
+
+const (
+	// CorrelationOldEventTag old event has Tag
+	CorrelationOldEventTag CorrelationConditionType = 0
+	// CorrelationNewEventTag new event has Tag
+	CorrelationNewEventTag CorrelationConditionType = 1
+	// CorrelationNewEventHostGroup new event belongs to GroupID
+	CorrelationNewEventHostGroup CorrelationConditionType = 2
+	// CorrelationEventTagPair value of OldTag of the old event matches value of NewTag of the new event
+	CorrelationEventTagPair CorrelationConditionType = 3
+	// CorrelationOldEventTagValue value of Tag of the old event matches Value
+	CorrelationOldEventTagValue CorrelationConditionType = 4
+	// CorrelationNewEventTagValue value of Tag of the new event matches Value
+	CorrelationNewEventTagValue CorrelationConditionType = 5
+)
+
+const (
+	// CorrelationEqual equals, also used as "in" for host groups
+	CorrelationEqual CorrelationOperator = 0
+	// CorrelationNotEqual does not equal, also used as "not in" for host groups
+	CorrelationNotEqual CorrelationOperator = 1
+	// CorrelationLike contains, tag value conditions only
+	CorrelationLike CorrelationOperator = 2
+	// CorrelationNotLike does not contain, tag value conditions only
+	CorrelationNotLike CorrelationOperator = 3
+)
+
+const (
+	// CorrelationCloseOld close old events
+	CorrelationCloseOld CorrelationOperationType = 0
+	// CorrelationCloseNew close new event
+	CorrelationCloseNew CorrelationOperationType = 1
+)
+
+// CorrelationCondition represent Zabbix correlation filter condition
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/correlation/object#correlation-filter-condition
+type CorrelationCondition struct {
+	Type      CorrelationConditionType `json:"type,string"`
+	Tag       string                   `json:"tag,omitempty"`
+	GroupID   string                   `json:"groupid,omitempty"`
+	OldTag    string                   `json:"oldtag,omitempty"`
+	NewTag    string                   `json:"newtag,omitempty"`
+	Value     string                   `json:"value,omitempty"`
+	Operator  CorrelationOperator      `json:"operator,omitempty,string"`
+	FormulaID string                   `json:"formulaid,omitempty"`
+}
+
+// CorrelationConditions is an array of CorrelationCondition
+type CorrelationConditions []CorrelationCondition
+
+// CorrelationFilter represent Zabbix correlation filter
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/correlation/object#correlation-filter
+type CorrelationFilter struct {
+	EvalType CorrelationEvalType `json:"evaltype,string"`
+	// EvalFormula read only, generated expression of the filter
+	EvalFormula string `json:"eval_formula,omitempty"`
+	// Formula custom expression using condition FormulaID, e.g. "A and (B or C)"
+	Formula    string                `json:"formula,omitempty"`
+	Conditions CorrelationConditions `json:"conditions"`
+}
+
+// CorrelationOperation represent Zabbix correlation operation
+type CorrelationOperation struct {
+	Type CorrelationOperationType `json:"type,string"`
+}
+
+// CorrelationOperations is an array of CorrelationOperation
+type CorrelationOperations []CorrelationOperation
+
+// Correlation represent Zabbix event correlation object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/correlation/object
+type Correlation struct {
+	CorrelationID string                `json:"correlationid,omitempty"`
+	Name          string                `json:"name"`
+	Description   string                `json:"description,omitempty"`
+	Status        StatusType            `json:"status,string"`
+	Filter        CorrelationFilter     `json:"filter"`
+	Operations    CorrelationOperations `json:"operations"`
+}
+
+// Correlations is an array of Correlation
+type Correlations []Correlation
+
+// prepCorrelations returns copies of the correlations without read only fields.
+func prepCorrelations(correlations Correlations) Correlations {
+	res := make(Correlations, len(correlations))
+	for i, correlation := range correlations {
+		correlation.Filter.EvalFormula = ""
+		res[i] = correlation
+	}
+	return res
+}
+
+// CorrelationsGet Wrapper for correlation.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/correlation/get
+func (api *API) CorrelationsGet(params Params) (res Correlations, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("correlation.get", params, &res)
+	return
+}
+
+// CorrelationGetByID Gets correlation with its filter and operations by Id only if there is exactly 1 matching correlation.
+func (api *API) CorrelationGetByID(id string) (res *Correlation, err error) {
+	correlations, err := api.CorrelationsGet(Params{
+		"correlationids":   id,
+		"selectFilter":     "extend",
+		"selectOperations": "extend",
+	})
+	if err != nil {
+		return
+	}
+
+	if len(correlations) == 1 {
+		res = &correlations[0]
+	} else {
+		e := ExpectedOneResult(len(correlations))
+		err = &e
+	}
+	return
+}
+
+// CorrelationsCreate Wrapper for correlation.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/correlation/create
+func (api *API) CorrelationsCreate(correlations Correlations) (err error) {
+	response, err := api.CallWithError("correlation.create", prepCorrelations(correlations))
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	correlationids := result["correlationids"].([]interface{})
+	for i, id := range correlationids {
+		correlations[i].CorrelationID = id.(string)
+	}
+	return
+}
+
+// CorrelationsUpdate Wrapper for correlation.update
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/correlation/update
+func (api *API) CorrelationsUpdate(correlations Correlations) (err error) {
+	_, err = api.CallWithError("correlation.update", prepCorrelations(correlations))
+	return
+}
+
+// CorrelationsDelete Wrapper for correlation.delete
+// Cleans CorrelationID in all correlations elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/correlation/delete
+func (api *API) CorrelationsDelete(correlations Correlations) (err error) {
+	ids := make([]string, len(correlations))
+	for i, correlation := range correlations {
+		ids[i] = correlation.CorrelationID
+	}
+
+	err = api.CorrelationsDeleteByIds(ids)
+	if err == nil {
+		for i := range correlations {
+			correlations[i].CorrelationID = ""
+		}
+	}
+	return
+}
+
+// CorrelationsDeleteByIds Wrapper for correlation.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/correlation/delete
+func (api *API) CorrelationsDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("correlation.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	correlationids := result["correlationids"].([]interface{})
+	if len(ids) != len(correlationids) {
+		err = &ExpectedMore{len(ids), len(correlationids)}
+	}
+	return
+}
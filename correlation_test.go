@@ -0,0 +1,75 @@
+package zabbix_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestCorrelations(t *testing.T) {
+	api := getAPI(t)
+
+	correlations := zapi.Correlations{{
+		Name:   fmt.Sprintf("zabbix-testing-%d", rand.Int()),
+		Status: zapi.Disabled,
+		Filter: zapi.CorrelationFilter{
+			EvalType: zapi.CorrelationCustom,
+			Formula:  "A and B",
+			Conditions: zapi.CorrelationConditions{
+				{Type: zapi.CorrelationNewEventTag, Tag: "service", FormulaID: "A"},
+				{Type: zapi.CorrelationEventTagPair, OldTag: "service", NewTag: "service", FormulaID: "B"},
+			},
+		},
+		Operations: zapi.CorrelationOperations{{Type: zapi.CorrelationCloseOld}},
+	}}
+	err := api.CorrelationsCreate(correlations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if correlations[0].CorrelationID == "" {
+		t.Fatalf("Id is empty: %#v", correlations[0])
+	}
+
+	correlation, err := api.CorrelationGetByID(correlations[0].CorrelationID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filter := correlation.Filter
+	if correlation.Name != correlations[0].Name || filter.EvalType != zapi.CorrelationCustom || filter.Formula != "A and B" || len(filter.Conditions) != 2 {
+		t.Errorf("Bad correlation: %#v", correlation)
+	}
+	if filter.EvalFormula == "" {
+		t.Errorf("Eval formula is empty: %#v", filter)
+	}
+	if len(correlation.Operations) != 1 || correlation.Operations[0].Type != zapi.CorrelationCloseOld {
+		t.Errorf("Bad operations: %#v", correlation.Operations)
+	}
+
+	// read only eval formula is not sent back
+	correlation.Description = "updated"
+	err = api.CorrelationsUpdate(zapi.Correlations{*correlation})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if correlation.Filter.EvalFormula == "" {
+		t.Errorf("Correlation is changed: %#v", correlation)
+	}
+
+	correlation, err = api.CorrelationGetByID(correlations[0].CorrelationID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if correlation.Description != "updated" {
+		t.Errorf("Correlation is not updated: %#v", correlation)
+	}
+
+	err = api.CorrelationsDelete(correlations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if correlations[0].CorrelationID != "" {
+		t.Errorf("Id is not cleaned: %#v", correlations[0])
+	}
+}
@@ -0,0 +1,457 @@
+package zabbix
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+type (
+	// WidgetFieldType type of the value of a widget field
+	// see "type" in https://www.zabbix.com/documentation/current/en/manual/api/reference/dashboard/object#dashboard-widget-field
+	WidgetFieldType int
+
+	// WidgetViewMode whether the widget header is displayed
+	WidgetViewMode int
+
+	// DashboardPermission access level to a shared dashboard
+	DashboardPermission int
+
+	// Sharing whether a dashboard or a map is visible to all users,
+	// see "private" in https://www.zabbix.com/documentation/current/en/manual/api/reference/dashboard/object
+	Sharing int
+)
+
+const (
+	// SharingDefault private is not sent, Zabbix creates private objects and keeps the current value on update
+	SharingDefault Sharing = iota
+	// SharingPublic visible to all users
+	SharingPublic
+	// SharingPrivate visible to the owner and the users it is shared with
+	SharingPrivate
+)
+
+// DashboardRows is the height of a dashboard page grid
+const DashboardRows = 64
+
+const (
+	WidgetFieldInteger        WidgetFieldType = 0
+	WidgetFieldString         WidgetFieldType = 1
+	WidgetFieldHostGroup      WidgetFieldType = 2
+	WidgetFieldHost           WidgetFieldType = 3
+	WidgetFieldItem           WidgetFieldType = 4
+	WidgetFieldItemPrototype  WidgetFieldType = 5
+	WidgetFieldGraph          WidgetFieldType = 6
+	WidgetFieldGraphPrototype WidgetFieldType = 7
+	WidgetFieldMap            WidgetFieldType = 8
+	WidgetFieldService        WidgetFieldType = 9
+	WidgetFieldSLA            WidgetFieldType = 10
+	WidgetFieldUser           WidgetFieldType = 11
+	WidgetFieldAction         WidgetFieldType = 12
+	WidgetFieldMediaType      WidgetFieldType = 13
+)
+
+const (
+	// WidgetViewDefault header is displayed (default)
+	WidgetViewDefault WidgetViewMode = 0
+	// WidgetViewHiddenHeader header is hidden
+	WidgetViewHiddenHeader WidgetViewMode = 1
+)
+
+const (
+	// DashboardReadOnly read-only access
+	DashboardReadOnly DashboardPermission = 2
+	// DashboardReadWrite read-write access
+	DashboardReadWrite DashboardPermission = 3
+)
+
+// Widget types, see "type" in https://www.zabbix.com/documentation/current/en/manual/api/reference/dashboard/object#dashboard-widget
+const (
+	WidgetActionLog    = "actionlog"
+	WidgetClock        = "clock"
+	WidgetGraph        = "graph"
+	WidgetSVGGraph     = "svggraph"
+	WidgetItemValue    = "item"
+	WidgetMap          = "map"
+	WidgetPlainText    = "plaintext"
+	WidgetProblems     = "problems"
+	WidgetProblemsBySV = "problemsbysv"
+	WidgetSystemInfo   = "systeminfo"
+	WidgetURL          = "url"
+)
+
+// MarshalJSON sends SharingPublic as "0" and SharingPrivate as "1".
+func (s Sharing) MarshalJSON() ([]byte, error) {
+	switch s {
+	case SharingPublic:
+		return json.Marshal("0")
+	case SharingPrivate:
+		return json.Marshal("1")
+	}
+	return nil, fmt.Errorf("unexpected sharing %d", int(s))
+}
+
+// UnmarshalJSON parses "private" returned by the API.
+func (s *Sharing) UnmarshalJSON(data []byte) error {
+	var private string
+	if err := json.Unmarshal(data, &private); err != nil {
+		return err
+	}
+	switch private {
+	case "0":
+		*s = SharingPublic
+	case "1":
+		*s = SharingPrivate
+	default:
+		return fmt.Errorf("unexpected private %q", private)
+	}
+	return nil
+}
+
+// WidgetField represents a field of a dashboard widget. Value holds the
+// reference ID for object fields and the value itself for integer and string fields.
+type WidgetField struct {
+	Type  WidgetFieldType `json:"type,string"`
+	Name  string          `json:"name"`
+	Value string          `json:"value"`
+}
+
+// WidgetFields is an array of WidgetField
+type WidgetFields []WidgetField
+
+// DashboardWidget represent Zabbix dashboard widget object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/dashboard/object#dashboard-widget
+type DashboardWidget struct {
+	WidgetID string         `json:"widgetid,omitempty"`
+	Type     string         `json:"type"`
+	Name     string         `json:"name,omitempty"`
+	X        int            `json:"x,string"`
+	Y        int            `json:"y,string"`
+	Width    int            `json:"width,string"`
+	Height   int            `json:"height,string"`
+	ViewMode WidgetViewMode `json:"view_mode,string"`
+	Fields   WidgetFields   `json:"fields,omitempty"`
+}
+
+// DashboardWidgets is an array of DashboardWidget
+type DashboardWidgets []DashboardWidget
+
+// DashboardPage represent Zabbix dashboard page object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/dashboard/object#dashboard-page
+type DashboardPage struct {
+	DashboardPageID string           `json:"dashboard_pageid,omitempty"`
+	Name            string           `json:"name,omitempty"`
+	DisplayPeriod   int              `json:"display_period,string"`
+	Widgets         DashboardWidgets `json:"widgets,omitempty"`
+}
+
+// DashboardPages is an array of DashboardPage
+type DashboardPages []DashboardPage
+
+// DashboardUser shares the dashboard with a user
+type DashboardUser struct {
+	UserID     string              `json:"userid"`
+	Permission DashboardPermission `json:"permission,string"`
+}
+
+// DashboardUserGroup shares the dashboard with a user group
+type DashboardUserGroup struct {
+	UserGroupID string              `json:"usrgrpid"`
+	Permission  DashboardPermission `json:"permission,string"`
+}
+
+// Dashboard represent Zabbix dashboard object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/dashboard/object
+type Dashboard struct {
+	DashboardID   string               `json:"dashboardid,omitempty"`
+	Name          string               `json:"name"`
+	UserID        string               `json:"userid,omitempty"`
+	Private       Sharing              `json:"private,omitempty"`
+	DisplayPeriod int                  `json:"display_period,omitempty,string"`
+	AutoStart     string               `json:"auto_start,omitempty"`
+	Pages         DashboardPages       `json:"pages,omitempty"`
+	Users         []DashboardUser      `json:"users,omitempty"`
+	UserGroups    []DashboardUserGroup `json:"userGroups,omitempty"`
+}
+
+// Dashboards is an array of Dashboard
+type Dashboards []Dashboard
+
+// TemplateDashboard represent Zabbix template dashboard object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templatedashboard/object
+type TemplateDashboard struct {
+	DashboardID   string         `json:"dashboardid,omitempty"`
+	TemplateID    string         `json:"templateid,omitempty"`
+	Name          string         `json:"name"`
+	DisplayPeriod int            `json:"display_period,omitempty,string"`
+	AutoStart     string         `json:"auto_start,omitempty"`
+	Pages         DashboardPages `json:"pages,omitempty"`
+}
+
+// TemplateDashboards is an array of TemplateDashboard
+type TemplateDashboards []TemplateDashboard
+
+// DashboardColumns returns width of the dashboard grid, it was made finer in 7.0.
+func (api *API) DashboardColumns() int {
+	if api.Config.Version >= 70000 {
+		return 72
+	}
+	return 24
+}
+
+// widgetFieldName returns name of the i-th value of a widget field. Since 6.4
+// fields referencing objects are indexed, e.g. "graphid.0".
+func (api *API) widgetFieldName(name string, i int) string {
+	if api.Config.Version >= 60400 {
+		return name + "." + strconv.Itoa(i)
+	}
+	return name
+}
+
+// GraphWidget returns a classic graph widget showing the graph.
+func (api *API) GraphWidget(graph Graph) DashboardWidget {
+	return DashboardWidget{
+		Type: WidgetGraph,
+		Name: graph.Name,
+		Fields: WidgetFields{
+			{Type: WidgetFieldInteger, Name: "source_type", Value: "0"},
+			{Type: WidgetFieldGraph, Name: api.widgetFieldName("graphid", 0), Value: graph.GraphID},
+		},
+	}
+}
+
+// ItemValueWidget returns a widget showing the latest value of the item.
+func (api *API) ItemValueWidget(item Item) DashboardWidget {
+	return DashboardWidget{
+		Type: WidgetItemValue,
+		Name: item.Name,
+		Fields: WidgetFields{
+			{Type: WidgetFieldItem, Name: api.widgetFieldName("itemid", 0), Value: item.ItemID},
+		},
+	}
+}
+
+// PlainTextWidget returns a widget showing the latest values of the items as plain text.
+func (api *API) PlainTextWidget(items Items) DashboardWidget {
+	fields := make(WidgetFields, len(items))
+	for i, item := range items {
+		fields[i] = WidgetField{Type: WidgetFieldItem, Name: api.widgetFieldName("itemids", i), Value: item.ItemID}
+	}
+	return DashboardWidget{Type: WidgetPlainText, Fields: fields}
+}
+
+// ProblemsWidget returns a widget listing problems of the host groups, all problems if none given.
+func (api *API) ProblemsWidget(groups HostGroupIDs) DashboardWidget {
+	fields := make(WidgetFields, len(groups))
+	for i, group := range groups {
+		fields[i] = WidgetField{Type: WidgetFieldHostGroup, Name: api.widgetFieldName("groupids", i), Value: group.GroupID}
+	}
+	return DashboardWidget{Type: WidgetProblems, Fields: fields}
+}
+
+// GraphWidgetsGrid lays out graph widgets for the graphs row by row,
+// as many widgets of the given size as fit into the dashboard width.
+// Returns an error if the widgets don't fit into DashboardRows of a single page.
+func (api *API) GraphWidgetsGrid(graphs Graphs, width, height int) (res DashboardWidgets, err error) {
+	columns := api.DashboardColumns()
+	if width <= 0 || width > columns {
+		width = columns
+	}
+	if height <= 0 {
+		return nil, fmt.Errorf("widget height must be positive, got %d", height)
+	}
+	perRow := columns / width
+	if rows := (len(graphs) + perRow - 1) / perRow * height; rows > DashboardRows {
+		return nil, fmt.Errorf("%d widgets take %d rows, a page has %d", len(graphs), rows, DashboardRows)
+	}
+
+	res = make(DashboardWidgets, len(graphs))
+	for i, graph := range graphs {
+		w := api.GraphWidget(graph)
+		w.X = (i % perRow) * width
+		w.Y = (i / perRow) * height
+		w.Width = width
+		w.Height = height
+		res[i] = w
+	}
+	return
+}
+
+// DashboardsGet Wrapper for dashboard.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/dashboard/get
+func (api *API) DashboardsGet(params Params) (res Dashboards, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("dashboard.get", params, &res)
+	return
+}
+
+// DashboardGetByID Gets dashboard with pages and sharing by Id only if there is exactly 1 matching dashboard.
+func (api *API) DashboardGetByID(id string) (res *Dashboard, err error) {
+	dashboards, err := api.DashboardsGet(Params{
+		"dashboardids":     id,
+		"selectPages":      "extend",
+		"selectUsers":      "extend",
+		"selectUserGroups": "extend",
+	})
+	if err != nil {
+		return
+	}
+
+	if len(dashboards) == 1 {
+		res = &dashboards[0]
+	} else {
+		e := ExpectedOneResult(len(dashboards))
+		err = &e
+	}
+	return
+}
+
+// DashboardsCreate Wrapper for dashboard.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/dashboard/create
+func (api *API) DashboardsCreate(dashboards Dashboards) (err error) {
+	response, err := api.CallWithError("dashboard.create", dashboards)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	dashboardids := result["dashboardids"].([]interface{})
+	for i, id := range dashboardids {
+		dashboards[i].DashboardID = id.(string)
+	}
+	return
+}
+
+// DashboardsUpdate Wrapper for dashboard.update
+// Pages are replaced when given and kept when nil.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/dashboard/update
+func (api *API) DashboardsUpdate(dashboards Dashboards) (err error) {
+	_, err = api.CallWithError("dashboard.update", dashboards)
+	return
+}
+
+// DashboardsDelete Wrapper for dashboard.delete
+// Cleans DashboardID in all dashboards elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/dashboard/delete
+func (api *API) DashboardsDelete(dashboards Dashboards) (err error) {
+	ids := make([]string, len(dashboards))
+	for i, dashboard := range dashboards {
+		ids[i] = dashboard.DashboardID
+	}
+
+	err = api.DashboardsDeleteByIds(ids)
+	if err == nil {
+		for i := range dashboards {
+			dashboards[i].DashboardID = ""
+		}
+	}
+	return
+}
+
+// DashboardsDeleteByIds Wrapper for dashboard.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/dashboard/delete
+func (api *API) DashboardsDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("dashboard.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	dashboardids := result["dashboardids"].([]interface{})
+	if len(ids) != len(dashboardids) {
+		err = &ExpectedMore{len(ids), len(dashboardids)}
+	}
+	return
+}
+
+// TemplateDashboardsGet Wrapper for templatedashboard.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templatedashboard/get
+func (api *API) TemplateDashboardsGet(params Params) (res TemplateDashboards, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("templatedashboard.get", params, &res)
+	return
+}
+
+// TemplateDashboardGetByID Gets template dashboard with pages by Id only if there is exactly 1 matching dashboard.
+func (api *API) TemplateDashboardGetByID(id string) (res *TemplateDashboard, err error) {
+	dashboards, err := api.TemplateDashboardsGet(Params{"dashboardids": id, "selectPages": "extend"})
+	if err != nil {
+		return
+	}
+
+	if len(dashboards) == 1 {
+		res = &dashboards[0]
+	} else {
+		e := ExpectedOneResult(len(dashboards))
+		err = &e
+	}
+	return
+}
+
+// TemplateDashboardsCreate Wrapper for templatedashboard.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templatedashboard/create
+func (api *API) TemplateDashboardsCreate(dashboards TemplateDashboards) (err error) {
+	response, err := api.CallWithError("templatedashboard.create", dashboards)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	dashboardids := result["dashboardids"].([]interface{})
+	for i, id := range dashboardids {
+		dashboards[i].DashboardID = id.(string)
+	}
+	return
+}
+
+// TemplateDashboardsUpdate Wrapper for templatedashboard.update
+// Pages are replaced when given and kept when nil.
+// The template of a dashboard can't be changed, TemplateID is not sent.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templatedashboard/update
+func (api *API) TemplateDashboardsUpdate(dashboards TemplateDashboards) (err error) {
+	update := make(TemplateDashboards, len(dashboards))
+	for i, dashboard := range dashboards {
+		dashboard.TemplateID = ""
+		update[i] = dashboard
+	}
+	_, err = api.CallWithError("templatedashboard.update", update)
+	return
+}
+
+// TemplateDashboardsDelete Wrapper for templatedashboard.delete
+// Cleans DashboardID in all dashboards elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templatedashboard/delete
+func (api *API) TemplateDashboardsDelete(dashboards TemplateDashboards) (err error) {
+	ids := make([]string, len(dashboards))
+	for i, dashboard := range dashboards {
+		ids[i] = dashboard.DashboardID
+	}
+
+	err = api.TemplateDashboardsDeleteByIds(ids)
+	if err == nil {
+		for i := range dashboards {
+			dashboards[i].DashboardID = ""
+		}
+	}
+	return
+}
+
+// TemplateDashboardsDeleteByIds Wrapper for templatedashboard.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templatedashboard/delete
+func (api *API) TemplateDashboardsDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("templatedashboard.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	dashboardids := result["dashboardids"].([]interface{})
+	if len(ids) != len(dashboardids) {
+		err = &ExpectedMore{len(ids), len(dashboardids)}
+	}
+	return
+}
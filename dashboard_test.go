@@ -0,0 +1,151 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestDashboards(t *testing.T) {
+	api := getAPI(t)
+	if api.Config.Version < 50400 {
+		t.Skip("dashboard pages are available since 5.4")
+	}
+
+	dashboards := zapi.Dashboards{{
+		Name:    fmt.Sprintf("zabbix-testing-%d", rand.Int()),
+		Private: zapi.SharingPrivate,
+		Pages: zapi.DashboardPages{{
+			Widgets: zapi.DashboardWidgets{api.ProblemsWidget(nil)},
+		}},
+	}}
+	dashboards[0].Pages[0].Widgets[0].Width = 12
+	dashboards[0].Pages[0].Widgets[0].Height = 5
+	err := api.DashboardsCreate(dashboards)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dashboards[0].DashboardID == "" {
+		t.Fatalf("Id is empty: %#v", dashboards[0])
+	}
+
+	dashboard, err := api.DashboardGetByID(dashboards[0].DashboardID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dashboard.Private != zapi.SharingPrivate || len(dashboard.Pages) != 1 || len(dashboard.Pages[0].Widgets) != 1 {
+		t.Errorf("Bad dashboard: %#v", dashboard)
+	}
+
+	// pages are kept when not given
+	update := zapi.Dashboard{DashboardID: dashboard.DashboardID, Name: dashboard.Name + "-renamed", Private: zapi.SharingPublic}
+	err = api.DashboardsUpdate(zapi.Dashboards{update})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dashboard, err = api.DashboardGetByID(dashboards[0].DashboardID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dashboard.Name != update.Name || dashboard.Private != zapi.SharingPublic || len(dashboard.Pages) != 1 || len(dashboard.Pages[0].Widgets) != 1 {
+		t.Errorf("Dashboard is not updated: %#v", dashboard)
+	}
+
+	err = api.DashboardsDelete(dashboards)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dashboards[0].DashboardID != "" {
+		t.Errorf("Id is not cleaned: %#v", dashboards[0])
+	}
+}
+
+func TestDashboardSharing(t *testing.T) {
+	b, err := json.Marshal(zapi.Dashboard{Name: "default"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), `"private"`) {
+		t.Errorf("Default sharing is sent: %s", b)
+	}
+	if strings.Contains(string(b), `"pages"`) {
+		t.Errorf("Empty pages are sent: %s", b)
+	}
+
+	b, err = json.Marshal(zapi.Dashboard{Name: "public", Private: zapi.SharingPublic})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"private":"0"`) {
+		t.Errorf("Public sharing is not sent: %s", b)
+	}
+
+	var dashboard zapi.Dashboard
+	err = json.Unmarshal([]byte(`{"name": "private", "private": "1", "pages": []}`), &dashboard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dashboard.Private != zapi.SharingPrivate {
+		t.Errorf("Bad sharing: %#v", dashboard)
+	}
+}
+
+func TestWidgetFieldName(t *testing.T) {
+	items := zapi.Items{{ItemID: "1"}, {ItemID: "2"}}
+
+	api := &zapi.API{Config: zapi.Config{Version: 60000}}
+	fields := api.PlainTextWidget(items).Fields
+	if fields[0].Name != "itemids" || fields[1].Name != "itemids" {
+		t.Errorf("Bad fields: %#v", fields)
+	}
+
+	api = &zapi.API{Config: zapi.Config{Version: 60400}}
+	fields = api.PlainTextWidget(items).Fields
+	if fields[0].Name != "itemids.0" || fields[1].Name != "itemids.1" || fields[1].Value != "2" {
+		t.Errorf("Bad fields: %#v", fields)
+	}
+}
+
+func TestGraphWidgetsGrid(t *testing.T) {
+	graphs := zapi.Graphs{{GraphID: "1"}, {GraphID: "2"}, {GraphID: "3"}}
+
+	api := &zapi.API{Config: zapi.Config{Version: 70000}}
+	widgets, err := api.GraphWidgetsGrid(graphs, 36, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(widgets) != 3 {
+		t.Fatalf("Expected 3 widgets, got %#v", widgets)
+	}
+	w := widgets[2]
+	if w.X != 0 || w.Y != 5 || w.Width != 36 || w.Height != 5 || w.Fields[1].Value != "3" {
+		t.Errorf("Bad widget: %#v", w)
+	}
+	if widgets[1].X != 36 || widgets[1].Y != 0 {
+		t.Errorf("Bad widget: %#v", widgets[1])
+	}
+
+	// too wide widgets take the whole row
+	api = &zapi.API{Config: zapi.Config{Version: 60000}}
+	widgets, err = api.GraphWidgetsGrid(graphs, 100, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if widgets[2].Width != 24 || widgets[2].X != 0 || widgets[2].Y != 10 {
+		t.Errorf("Bad widget: %#v", widgets[2])
+	}
+
+	_, err = api.GraphWidgetsGrid(graphs, 12, 0)
+	if err == nil {
+		t.Error("Expected error for zero height")
+	}
+	_, err = api.GraphWidgetsGrid(graphs, 24, 30)
+	if err == nil {
+		t.Error("Expected error for widgets not fitting the page")
+	}
+}
@@ -0,0 +1,271 @@
+package zabbix
+
+type (
+	// DiscoveryCheckType type of the discovery check
+	// see "type" in https://www.zabbix.com/documentation/current/en/manual/api/reference/dcheck/object
+	DiscoveryCheckType int
+
+	// DiscoveryStatus status of a discovered host or service
+	DiscoveryStatus int
+)
+
+const (
+	DiscoverySSH         DiscoveryCheckType = 0
+	DiscoveryLDAP        DiscoveryCheckType = 1
+	DiscoverySMTP        DiscoveryCheckType = 2
+	DiscoveryFTP         DiscoveryCheckType = 3
+	DiscoveryHTTP        DiscoveryCheckType = 4
+	DiscoveryPOP         DiscoveryCheckType = 5
+	DiscoveryNNTP        DiscoveryCheckType = 6
+	DiscoveryIMAP        DiscoveryCheckType = 7
+	DiscoveryTCP         DiscoveryCheckType = 8
+	DiscoveryZabbixAgent DiscoveryCheckType = 9
+	DiscoverySNMPv1      DiscoveryCheckType = 10
+	DiscoverySNMPv2c     DiscoveryCheckType = 11
+	DiscoveryICMP        DiscoveryCheckType = 12
+	DiscoverySNMPv3      DiscoveryCheckType = 13
+	DiscoveryHTTPS       DiscoveryCheckType = 14
+	DiscoveryTelnet      DiscoveryCheckType = 15
+)
+
+const (
+	// DiscoveryUp host or service is up
+	DiscoveryUp DiscoveryStatus = 0
+	// DiscoveryDown host or service is down
+	DiscoveryDown DiscoveryStatus = 1
+)
+
+// DiscoveryCheck represent Zabbix discovery check object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/dcheck/object
+type DiscoveryCheck struct {
+	DCheckID string             `json:"dcheckid,omitempty"`
+	Type     DiscoveryCheckType `json:"type,string"`
+	Ports    string             `json:"ports,omitempty"`
+	// Key item key for Zabbix agent checks and OID for SNMP checks
+	Key           string `json:"key_,omitempty"`
+	Uniq          string `json:"uniq,omitempty"`
+	HostSource    string `json:"host_source,omitempty"`
+	NameSource    string `json:"name_source,omitempty"`
+	AllowRedirect string `json:"allow_redirect,omitempty"`
+
+	// SNMP Fields
+	SNMPCommunity        string `json:"snmp_community,omitempty"`
+	SNMPv3AuthPassphrase string `json:"snmpv3_authpassphrase,omitempty"`
+	SNMPv3AuthProtocol   string `json:"snmpv3_authprotocol,omitempty"`
+	SNMPv3ContextName    string `json:"snmpv3_contextname,omitempty"`
+	SNMPv3PrivPassphrase string `json:"snmpv3_privpassphrase,omitempty"`
+	SNMPv3PrivProtocol   string `json:"snmpv3_privprotocol,omitempty"`
+	SNMPv3SecurityLevel  string `json:"snmpv3_securitylevel,omitempty"`
+	SNMPv3SecurityName   string `json:"snmpv3_securityname,omitempty"`
+}
+
+// DiscoveryChecks is an array of DiscoveryCheck
+type DiscoveryChecks []DiscoveryCheck
+
+// DiscoveryRule represent Zabbix network discovery rule object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/drule/object
+type DiscoveryRule struct {
+	DRuleID string     `json:"druleid,omitempty"`
+	Name    string     `json:"name"`
+	IPRange string     `json:"iprange"`
+	Delay   string     `json:"delay,omitempty"`
+	ProxyID string     `json:"proxy_hostid,omitempty"`
+	Status  StatusType `json:"status,string"`
+	// read only, not sent
+	Error string `json:"error,omitempty"`
+
+	Checks DiscoveryChecks `json:"dchecks"`
+
+	// since 7.0 ProxyID is sent and read back from this one
+	RawProxyID string `json:"proxyid,omitempty"`
+}
+
+// DiscoveryRules is an array of DiscoveryRule
+type DiscoveryRules []DiscoveryRule
+
+// DiscoveredService represent Zabbix discovered service object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/dservice/object
+type DiscoveredService struct {
+	DServiceID string          `json:"dserviceid"`
+	DHostID    string          `json:"dhostid"`
+	DCheckID   string          `json:"dcheckid"`
+	IP         string          `json:"ip"`
+	DNS        string          `json:"dns"`
+	Port       string          `json:"port"`
+	Status     DiscoveryStatus `json:"status,string"`
+	Value      string          `json:"value"`
+	LastUp     int64           `json:"lastup,string"`
+	LastDown   int64           `json:"lastdown,string"`
+}
+
+// DiscoveredServices is an array of DiscoveredService
+type DiscoveredServices []DiscoveredService
+
+// Unmonitored returns services whose IP or DNS name is not used by an interface of the hosts.
+// Hosts must be fetched with their interfaces.
+func (services DiscoveredServices) Unmonitored(hosts Hosts) (res DiscoveredServices) {
+	known := map[string]bool{}
+	for _, h := range hosts {
+		for _, in := range h.Interfaces {
+			if in.IP != "" {
+				known[in.IP] = true
+			}
+			if in.DNS != "" {
+				known[in.DNS] = true
+			}
+		}
+	}
+
+	for _, s := range services {
+		if known[s.IP] || (s.DNS != "" && known[s.DNS]) {
+			continue
+		}
+		res = append(res, s)
+	}
+	return
+}
+
+// DiscoveredHost represent Zabbix discovered host object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/dhost/object
+type DiscoveredHost struct {
+	DHostID  string             `json:"dhostid"`
+	DRuleID  string             `json:"druleid"`
+	Status   DiscoveryStatus    `json:"status,string"`
+	LastUp   int64              `json:"lastup,string"`
+	LastDown int64              `json:"lastdown,string"`
+	Services DiscoveredServices `json:"dservices,omitempty"`
+}
+
+// DiscoveredHosts is an array of DiscoveredHost
+type DiscoveredHosts []DiscoveredHost
+
+// prepDiscoveryRules returns copies of rules ready to be sent:
+// read only error is cleared and proxy is sent under the name of the server version.
+func (api *API) prepDiscoveryRules(rules DiscoveryRules) DiscoveryRules {
+	res := make(DiscoveryRules, len(rules))
+	for i, rule := range rules {
+		rule.Error = ""
+		rule.RawProxyID = ""
+		if api.Config.Version >= 70000 {
+			rule.RawProxyID = rule.ProxyID
+			rule.ProxyID = ""
+		}
+		res[i] = rule
+	}
+	return res
+}
+
+// DiscoveryRulesGet Wrapper for drule.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/drule/get
+func (api *API) DiscoveryRulesGet(params Params) (res DiscoveryRules, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("drule.get", params, &res)
+
+	for i := range res {
+		if res[i].RawProxyID != "" {
+			res[i].ProxyID = res[i].RawProxyID
+			res[i].RawProxyID = ""
+		}
+	}
+	return
+}
+
+// DiscoveryRuleGetByID Gets discovery rule with its checks by Id only if there is exactly 1 matching rule.
+func (api *API) DiscoveryRuleGetByID(id string) (res *DiscoveryRule, err error) {
+	rules, err := api.DiscoveryRulesGet(Params{"druleids": id, "selectDChecks": "extend"})
+	if err != nil {
+		return
+	}
+
+	if len(rules) == 1 {
+		res = &rules[0]
+	} else {
+		e := ExpectedOneResult(len(rules))
+		err = &e
+	}
+	return
+}
+
+// DiscoveryRulesCreate Wrapper for drule.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/drule/create
+func (api *API) DiscoveryRulesCreate(rules DiscoveryRules) (err error) {
+	response, err := api.CallWithError("drule.create", api.prepDiscoveryRules(rules))
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	druleids := result["druleids"].([]interface{})
+	for i, id := range druleids {
+		rules[i].DRuleID = id.(string)
+	}
+	return
+}
+
+// DiscoveryRulesUpdate Wrapper for drule.update
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/drule/update
+func (api *API) DiscoveryRulesUpdate(rules DiscoveryRules) (err error) {
+	_, err = api.CallWithError("drule.update", api.prepDiscoveryRules(rules))
+	return
+}
+
+// DiscoveryRulesDelete Wrapper for drule.delete
+// Cleans DRuleID in all rules elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/drule/delete
+func (api *API) DiscoveryRulesDelete(rules DiscoveryRules) (err error) {
+	ids := make([]string, len(rules))
+	for i, rule := range rules {
+		ids[i] = rule.DRuleID
+	}
+
+	err = api.DiscoveryRulesDeleteByIds(ids)
+	if err == nil {
+		for i := range rules {
+			rules[i].DRuleID = ""
+		}
+	}
+	return
+}
+
+// DiscoveryRulesDeleteByIds Wrapper for drule.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/drule/delete
+func (api *API) DiscoveryRulesDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("drule.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	druleids := result["druleids"].([]interface{})
+	if len(ids) != len(druleids) {
+		err = &ExpectedMore{len(ids), len(druleids)}
+	}
+	return
+}
+
+// DiscoveredHostsGet Wrapper for dhost.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/dhost/get
+func (api *API) DiscoveredHostsGet(params Params) (res DiscoveredHosts, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("dhost.get", params, &res)
+	return
+}
+
+// DiscoveredHostsGetByRule Gets hosts found by the discovery rule with their services.
+func (api *API) DiscoveredHostsGetByRule(rule DiscoveryRule) (res DiscoveredHosts, err error) {
+	return api.DiscoveredHostsGet(Params{"druleids": rule.DRuleID, "selectDServices": "extend"})
+}
+
+// DiscoveredServicesGet Wrapper for dservice.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/dservice/get
+func (api *API) DiscoveredServicesGet(params Params) (res DiscoveredServices, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("dservice.get", params, &res)
+	return
+}
@@ -0,0 +1,87 @@
+package zabbix_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestDiscoveryRules(t *testing.T) {
+	api := getAPI(t)
+
+	proxies := zapi.Proxies{{
+		Host:    fmt.Sprintf("%s-%d", getHost(), rand.Int()),
+		Status:  zapi.ProxyActive,
+		Address: "127.0.0.1",
+	}}
+	err := api.ProxiesCreate(proxies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer api.ProxiesDelete(proxies)
+
+	rules := zapi.DiscoveryRules{{
+		Name:    fmt.Sprintf("zabbix-testing-%d", rand.Int()),
+		IPRange: "192.0.2.1-254",
+		Delay:   "1h",
+		ProxyID: proxies[0].ProxyID,
+		Status:  zapi.Disabled,
+		Checks: zapi.DiscoveryChecks{
+			{Type: zapi.DiscoveryICMP},
+			{
+				Type:                 zapi.DiscoverySNMPv3,
+				Ports:                "161",
+				Key:                  "1.3.6.1.2.1.1.1.0",
+				SNMPv3SecurityName:   "zabbix",
+				SNMPv3SecurityLevel:  "2",
+				SNMPv3AuthPassphrase: "authpass",
+				SNMPv3PrivPassphrase: "privpass",
+			},
+		},
+	}}
+	err = api.DiscoveryRulesCreate(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer api.DiscoveryRulesDelete(rules)
+	if rules[0].DRuleID == "" {
+		t.Fatalf("Id is empty: %#v", rules[0])
+	}
+	if rules[0].RawProxyID != "" || rules[0].ProxyID != proxies[0].ProxyID {
+		t.Errorf("Rule is changed: %#v", rules[0])
+	}
+
+	rule, err := api.DiscoveryRuleGetByID(rules[0].DRuleID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.ProxyID != proxies[0].ProxyID || rule.RawProxyID != "" || len(rule.Checks) != 2 {
+		t.Errorf("Bad rule: %#v", rule)
+	}
+	for _, check := range rule.Checks {
+		if check.Type == zapi.DiscoverySNMPv3 && check.SNMPv3PrivPassphrase != "privpass" {
+			t.Errorf("Bad check: %#v", check)
+		}
+	}
+
+	// read only error must not be sent back
+	rule.Error = "Cannot discover"
+	rule.Delay = "2h"
+	err = api.DiscoveryRulesUpdate(zapi.DiscoveryRules{*rule})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.Error == "" {
+		t.Errorf("Rule is changed: %#v", rule)
+	}
+
+	rule, err = api.DiscoveryRuleGetByID(rules[0].DRuleID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.Delay != "2h" || rule.ProxyID != proxies[0].ProxyID {
+		t.Errorf("Rule is not updated: %#v", rule)
+	}
+}
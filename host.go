@@ -12,6 +12,9 @@ type (
 	StatusType int
 
 	InventoryMode int
+
+	// HostMonitoredBy source of the host monitoring, since 7.0
+	HostMonitoredBy int
 )
 
 const (
@@ -29,6 +32,12 @@ const (
 	InventoryAutomatic InventoryMode = 1
 )
 
+const (
+	MonitoredByServer     HostMonitoredBy = 0
+	MonitoredByProxy      HostMonitoredBy = 1
+	MonitoredByProxyGroup HostMonitoredBy = 2
+)
+
 const (
 	// Monitored monitored host(default)
 	Monitored StatusType = 0
@@ -62,6 +71,15 @@ type Host struct {
 	ParentTemplateIDs TemplateIDs `json:"parentTemplates,omitempty"`
 	ProxyID           string      `json:"proxy_hostid,omitempty"`
 	Tags              Tags        `json:"tags,omitempty"`
+
+	// since 7.0 ProxyID is sent and read back from this one
+	RawProxyID string `json:"proxyid,omitempty"`
+
+	// MonitoredBy and ProxyGroupID since 7.0, MonitoredBy is derived from ProxyID or ProxyGroupID
+	// when left to server, set ProxyID to "0" to move the host back to the server
+	RawMonitoredBy *HostMonitoredBy `json:"monitored_by,string,omitempty"`
+	MonitoredBy    HostMonitoredBy  `json:"-"`
+	ProxyGroupID   string           `json:"proxy_groupid,omitempty"`
 }
 
 // Hosts is an array of Host
@@ -78,26 +96,15 @@ func (api *API) HostsGet(params Params) (res Hosts, err error) {
 	// fix up host details if present
 	for i := 0; i < len(res); i++ {
 		h := res[i]
-		for j := 0; j < len(h.Interfaces); j++ {
-			in := h.Interfaces[j]
-			res[i].Interfaces[j].Details = nil
-			if len(in.RawDetails) == 0 {
-				continue
-			}
-
-			asStr := string(in.RawDetails)
-			if asStr == "[]" {
-				continue
-			}
+		api.interfacesDetailsUnmarshal(h.Interfaces)
 
-			out := HostInterfaceDetail{}
-			// assume singular, if api changes, this will fault
-			err := json.Unmarshal(in.RawDetails, &out)
-			if err != nil {
-				api.printf("got error during unmarshal %s", err)
-				panic(err)
-			}
-			res[i].Interfaces[j].Details = &out
+		if h.RawProxyID != "" {
+			res[i].ProxyID = h.RawProxyID
+			res[i].RawProxyID = ""
+		}
+		if h.RawMonitoredBy != nil {
+			res[i].MonitoredBy = *h.RawMonitoredBy
+			res[i].RawMonitoredBy = nil
 		}
 
 		// omitted = disabled
@@ -109,18 +116,12 @@ func (api *API) HostsGet(params Params) (res Hosts, err error) {
 
 		// fix up host inventory if present
 		if len(h.RawInventory) != 0 {
-			// if its an empty array
-			asStr := string(h.RawInventory)
-			if asStr == "[]" || asStr == "{}" {
+			inv, err := inventoryUnmarshal(h.RawInventory)
+			if err != nil {
+				// unexpected shape, keep the raw value only
+				api.printf("got error during inventory unmarshal %s", err)
 				continue
 			}
-
-			// lets unbox
-			var inv Inventory
-			if err := json.Unmarshal(h.RawInventory, &inv); err != nil {
-				api.printf("got error during unmarshal %s", err)
-				panic(err)
-			}
 			res[i].Inventory = inv
 		}
 
@@ -129,6 +130,20 @@ func (api *API) HostsGet(params Params) (res Hosts, err error) {
 	return
 }
 
+// HostsGetBySearchInventory Gets hosts with their inventory whose inventory fields contain the given values.
+// Values support the wildcards and search options of host.get, see "searchInventory" in
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/host/get
+func (api *API) HostsGetBySearchInventory(search HostInventory, params Params) (res Hosts, err error) {
+	if params == nil {
+		params = Params{}
+	}
+	params["searchInventory"] = search.Map()
+	if _, present := params["selectInventory"]; !present {
+		params["selectInventory"] = "extend"
+	}
+	return api.HostsGet(params)
+}
+
 // HostsGetByHostGroupIds Gets hosts by host group Ids.
 func (api *API) HostsGetByHostGroupIds(ids []string) (res Hosts, err error) {
 	return api.HostsGet(Params{"groupids": ids})
@@ -175,34 +190,60 @@ func (api *API) HostGetByHost(host string) (res *Host, err error) {
 	return
 }
 
-// handle manual marshal
-func prepHosts(hosts Hosts) {
+// handle manual marshal, returns copies of the hosts with fields of the server version
+func (api *API) prepHosts(hosts Hosts) Hosts {
+	hosts = append(Hosts{}, hosts...)
 	for i := 0; i < len(hosts); i++ {
 		h := hosts[i]
-		for j := 0; j < len(h.Interfaces); j++ {
-			in := h.Interfaces[j]
-
-			if in.Details == nil {
-				continue
-			}
-
-			asB, _ := json.Marshal(in.Details)
-			hosts[i].Interfaces[j].RawDetails = json.RawMessage(asB)
+		hosts[i].Interfaces = prepInterfaces(h.Interfaces)
+		for j := range hosts[i].Interfaces {
+			hosts[i].Interfaces[j].HostID = ""
 		}
 		if h.Inventory != nil {
 			asB, _ := json.Marshal(h.Inventory)
 			hosts[i].RawInventory = json.RawMessage(asB)
 		}
+		hosts[i].UserMacros = api.prepMacros(h.UserMacros)
 		invMode := h.InventoryMode
-		h.RawInventoryMode = &invMode
+		hosts[i].RawInventoryMode = &invMode
+
+		if api.Config.Version >= 70000 {
+			hosts[i].RawMonitoredBy = nil
+			hosts[i].RawProxyID = ""
+			hosts[i].ProxyID = ""
+			hosts[i].ProxyGroupID = ""
+
+			monitoredBy := h.MonitoredBy
+			if monitoredBy == MonitoredByServer && h.ProxyGroupID != "" && h.ProxyGroupID != "0" {
+				monitoredBy = MonitoredByProxyGroup
+			} else if monitoredBy == MonitoredByServer && h.ProxyID != "" && h.ProxyID != "0" {
+				monitoredBy = MonitoredByProxy
+			}
+			switch monitoredBy {
+			case MonitoredByProxy:
+				hosts[i].RawProxyID = h.ProxyID
+			case MonitoredByProxyGroup:
+				hosts[i].ProxyGroupID = h.ProxyGroupID
+			default:
+				if h.ProxyID == "" && h.ProxyGroupID == "" {
+					// monitoring is not changed
+					continue
+				}
+			}
+			hosts[i].RawMonitoredBy = &monitoredBy
+		} else {
+			hosts[i].RawMonitoredBy = nil
+			hosts[i].RawProxyID = ""
+			hosts[i].ProxyGroupID = ""
+		}
 	}
+	return hosts
 }
 
 // HostsCreate Wrapper for host.create
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/host/create
 func (api *API) HostsCreate(hosts Hosts) (err error) {
-	prepHosts(hosts)
-	response, err := api.CallWithError("host.create", hosts)
+	response, err := api.CallWithError("host.create", api.prepHosts(hosts))
 	if err != nil {
 		return
 	}
@@ -218,8 +259,7 @@ func (api *API) HostsCreate(hosts Hosts) (err error) {
 // HostsUpdate Wrapper for host.update
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/host/update
 func (api *API) HostsUpdate(hosts Hosts) (err error) {
-	prepHosts(hosts)
-	_, err = api.CallWithError("host.update", hosts)
+	_, err = api.CallWithError("host.update", api.prepHosts(hosts))
 	return
 }
 
@@ -258,3 +298,90 @@ func (api *API) HostsDeleteByIds(ids []string) (err error) {
 	}
 	return
 }
+
+// HostID represent Zabbix HostID, used by mass operations
+type HostID struct {
+	HostID string `json:"hostid"`
+}
+
+// HostIDs is an array of HostID
+type HostIDs []HostID
+
+func hostIDs(hosts Hosts) HostIDs {
+	ids := make(HostIDs, len(hosts))
+	for i, host := range hosts {
+		ids[i] = HostID{host.HostID}
+	}
+	return ids
+}
+
+// values returns plain IDs, as taken by massremove methods
+func (ids HostIDs) values() []string {
+	res := make([]string, len(ids))
+	for i, id := range ids {
+		res[i] = id.HostID
+	}
+	return res
+}
+
+// HostMassOptions objects added to hosts by host.massadd, replacing those of hosts by host.massupdate
+// or removed from hosts by host.massremove, macros are removed by name
+type HostMassOptions struct {
+	Groups     HostGroupIDs   `json:"groups,omitempty"`
+	Interfaces HostInterfaces `json:"interfaces,omitempty"`
+	Macros     Macros         `json:"macros,omitempty"`
+	Templates  TemplateIDs    `json:"templates,omitempty"`
+	// TemplatesClear templates to unlink and clear, host.massupdate and host.massremove only
+	TemplatesClear TemplateIDs `json:"templates_clear,omitempty"`
+}
+
+// HostsMassAdd Wrapper for host.massadd
+// Adds groups, interfaces, macros and templates to the hosts, keeping existing ones.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/host/massadd
+func (api *API) HostsMassAdd(hosts Hosts, options HostMassOptions) (err error) {
+	options.Interfaces = prepInterfaces(options.Interfaces)
+	options.Macros = api.prepMacros(options.Macros)
+	_, err = api.CallWithError("host.massadd", struct {
+		Hosts HostIDs `json:"hosts"`
+		HostMassOptions
+	}{hostIDs(hosts), options})
+	return
+}
+
+// HostsMassUpdate Wrapper for host.massupdate
+// Replaces groups, interfaces, macros and templates of the hosts with the given ones.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/host/massupdate
+func (api *API) HostsMassUpdate(hosts Hosts, options HostMassOptions) (err error) {
+	options.Interfaces = prepInterfaces(options.Interfaces)
+	options.Macros = api.prepMacros(options.Macros)
+	_, err = api.CallWithError("host.massupdate", struct {
+		Hosts HostIDs `json:"hosts"`
+		HostMassOptions
+	}{hostIDs(hosts), options})
+	return
+}
+
+// HostsMassRemove Wrapper for host.massremove
+// Removes groups, interfaces, macros and templates from the hosts.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/host/massremove
+func (api *API) HostsMassRemove(hosts Hosts, options HostMassOptions) (err error) {
+	params := Params{"hostids": hostIDs(hosts).values()}
+	if len(options.Groups) != 0 {
+		params["groupids"] = options.Groups.values()
+	}
+	if len(options.Interfaces) != 0 {
+		options.Interfaces = prepInterfaces(options.Interfaces)
+		params["interfaces"] = options.Interfaces
+	}
+	if len(options.Macros) != 0 {
+		params["macros"] = options.Macros.names()
+	}
+	if len(options.Templates) != 0 {
+		params["templateids"] = options.Templates.values()
+	}
+	if len(options.TemplatesClear) != 0 {
+		params["templateids_clear"] = options.TemplatesClear.values()
+	}
+	_, err = api.CallWithError("host.massremove", params)
+	return
+}
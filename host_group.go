@@ -114,3 +114,62 @@ func (api *API) HostGroupsDeleteByIds(ids []string) (err error) {
 	}
 	return
 }
+
+func hostGroupIDs(hostGroups HostGroups) HostGroupIDs {
+	ids := make(HostGroupIDs, len(hostGroups))
+	for i, group := range hostGroups {
+		ids[i] = HostGroupID{group.GroupID}
+	}
+	return ids
+}
+
+// values returns plain IDs, as taken by massremove methods
+func (ids HostGroupIDs) values() []string {
+	res := make([]string, len(ids))
+	for i, id := range ids {
+		res[i] = id.GroupID
+	}
+	return res
+}
+
+// HostGroupsMassAdd Wrapper for hostgroup.massadd
+// Adds the hosts and, before 6.2, the templates to the host groups.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostgroup/massadd
+func (api *API) HostGroupsMassAdd(hostGroups HostGroups, hosts HostIDs, templates TemplateIDs) (err error) {
+	params := Params{"groups": hostGroupIDs(hostGroups)}
+	if len(hosts) != 0 {
+		params["hosts"] = hosts
+	}
+	if len(templates) != 0 {
+		params["templates"] = templates
+	}
+	_, err = api.CallWithError("hostgroup.massadd", params)
+	return
+}
+
+// HostGroupsMassRemove Wrapper for hostgroup.massremove
+// Removes the hosts and, before 6.2, the templates from the host groups.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostgroup/massremove
+func (api *API) HostGroupsMassRemove(hostGroups HostGroups, hosts HostIDs, templates TemplateIDs) (err error) {
+	params := Params{"groupids": hostGroupIDs(hostGroups).values()}
+	if len(hosts) != 0 {
+		params["hostids"] = hosts.values()
+	}
+	if len(templates) != 0 {
+		params["templateids"] = templates.values()
+	}
+	_, err = api.CallWithError("hostgroup.massremove", params)
+	return
+}
+
+// HostGroupsPropagate Wrapper for hostgroup.propagate, available since 6.2
+// Copies permissions and tag filters of the host groups to their subgroups.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostgroup/propagate
+func (api *API) HostGroupsPropagate(hostGroups HostGroups, permissions, tagFilters bool) (err error) {
+	_, err = api.CallWithError("hostgroup.propagate", Params{
+		"groups":      hostGroupIDs(hostGroups),
+		"permissions": permissions,
+		"tag_filters": tagFilters,
+	})
+	return
+}
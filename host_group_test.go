@@ -64,3 +64,40 @@ func TestHostGroups(t *testing.T) {
 		t.Errorf("Error deleting group.\nOld groups: %#v\nNew groups: %#v", groups, groups2)
 	}
 }
+
+func TestHostGroupsMass(t *testing.T) {
+	api := getAPI(t)
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+	group2 := CreateHostGroup(t)
+	defer DeleteHostGroup(group2, t)
+
+	host := CreateHost(group, t)
+	defer DeleteHost(host, t)
+
+	hosts := zapi.HostIDs{{host.HostID}}
+	err := api.HostGroupsMassAdd(zapi.HostGroups{*group2}, hosts, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := api.HostsGet(zapi.Params{"hostids": host.HostID, "groupids": group2.GroupID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 {
+		t.Errorf("Host is not added to the group: %#v", res)
+	}
+
+	err = api.HostGroupsMassRemove(zapi.HostGroups{*group2}, hosts, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = api.HostsGet(zapi.Params{"hostids": host.HostID, "groupids": group2.GroupID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 0 {
+		t.Errorf("Host is not removed from the group: %#v", res)
+	}
+}
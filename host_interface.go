@@ -5,6 +5,12 @@ import "encoding/json"
 type (
 	// InterfaceType different interface type
 	InterfaceType string
+
+	// InterfaceMainType whether the interface is the default one of its type on the host
+	InterfaceMainType string
+
+	// InterfaceUseIPType whether the connection is made by IP or DNS name
+	InterfaceUseIPType string
 )
 
 const (
@@ -21,23 +27,72 @@ const (
 	JMX InterfaceType = "4"
 )
 
+const (
+	// InterfaceNotMain not default interface
+	InterfaceNotMain InterfaceMainType = "0"
+	// InterfaceMain default interface
+	InterfaceMain InterfaceMainType = "1"
+)
+
+const (
+	// ConnectByDNS connect using host DNS name
+	ConnectByDNS InterfaceUseIPType = "0"
+	// ConnectByIP connect using host IP address
+	ConnectByIP InterfaceUseIPType = "1"
+)
+
 // HostInterface represents zabbix host interface type
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/hostinterface/object
 type HostInterface struct {
-	InterfaceID string               `json:"interfaceid,omitempty"`
-	DNS         string               `json:"dns"`
-	IP          string               `json:"ip"`
-	Main        string               `json:"main"`
-	Port        string               `json:"port"`
-	Type        InterfaceType        `json:"type"`
-	UseIP       string               `json:"useip"`
-	RawDetails  json.RawMessage      `json:"details,omitempty"`
-	Details     *HostInterfaceDetail `json:"-"`
+	InterfaceID string `json:"interfaceid,omitempty"`
+	// HostID required by hostinterface.create only, not sent with hosts
+	HostID     string               `json:"hostid,omitempty"`
+	DNS        string               `json:"dns"`
+	IP         string               `json:"ip"`
+	Main       InterfaceMainType    `json:"main"`
+	Port       string               `json:"port"`
+	Type       InterfaceType        `json:"type"`
+	UseIP      InterfaceUseIPType   `json:"useip"`
+	RawDetails json.RawMessage      `json:"details,omitempty"`
+	Details    *HostInterfaceDetail `json:"-"`
+
+	// read only, availability is reported per interface since 5.4
+	Available    AvailableType `json:"available,omitempty,string"`
+	Error        string        `json:"error,omitempty"`
+	ErrorsFrom   string        `json:"errors_from,omitempty"`
+	DisableUntil string        `json:"disable_until,omitempty"`
 }
 
 // HostInterfaces is an array of HostInterface
 type HostInterfaces []HostInterface
 
+// SNMP interface details values as of 7.0
+// see "details" in https://www.zabbix.com/documentation/current/en/manual/api/reference/hostinterface/object#details
+const (
+	SNMPVersion1  = "1"
+	SNMPVersion2c = "2"
+	SNMPVersion3  = "3"
+
+	SNMPv3NoAuthNoPriv = "0"
+	SNMPv3AuthNoPriv   = "1"
+	SNMPv3AuthPriv     = "2"
+
+	SNMPv3AuthMD5    = "0"
+	SNMPv3AuthSHA1   = "1"
+	SNMPv3AuthSHA224 = "2"
+	SNMPv3AuthSHA256 = "3"
+	SNMPv3AuthSHA384 = "4"
+	SNMPv3AuthSHA512 = "5"
+
+	SNMPv3PrivDES     = "0"
+	SNMPv3PrivAES128  = "1"
+	SNMPv3PrivAES192  = "2"
+	SNMPv3PrivAES256  = "3"
+	SNMPv3PrivAES192C = "4"
+	SNMPv3PrivAES256C = "5"
+)
+
+// HostInterfaceDetail represents details of SNMP interfaces
 type HostInterfaceDetail struct {
 	Version        string `json:"version,omitempty"`
 	Bulk           string `json:"bulk,omitempty"`
@@ -49,6 +104,174 @@ type HostInterfaceDetail struct {
 	AuthProtocol   string `json:"authprotocol,omitempty"`
 	PrivProtocol   string `json:"privprotocol,omitempty"`
 	ContextName    string `json:"contextname,omitempty"`
+	// MaxRepetitions max repetition count of SNMPv2 and SNMPv3 bulk requests, since 6.4
+	MaxRepetitions string `json:"max_repetitions,omitempty"`
 }
 
 type HostInterfaceDetails []HostInterfaceDetail
+
+func (api *API) interfacesDetailsUnmarshal(interfaces HostInterfaces) {
+	for j := 0; j < len(interfaces); j++ {
+		in := interfaces[j]
+		interfaces[j].Details = nil
+		if len(in.RawDetails) == 0 {
+			continue
+		}
+
+		asStr := string(in.RawDetails)
+		if asStr == "[]" {
+			continue
+		}
+
+		out := HostInterfaceDetail{}
+		// assume singular, if api changes, this will fault
+		err := json.Unmarshal(in.RawDetails, &out)
+		if err != nil {
+			api.printf("got error during unmarshal %s", err)
+			panic(err)
+		}
+		interfaces[j].Details = &out
+	}
+}
+
+// handle manual marshal, returns copies of the interfaces without read only fields
+func prepInterfaces(interfaces HostInterfaces) HostInterfaces {
+	if interfaces == nil {
+		return nil
+	}
+	res := make(HostInterfaces, len(interfaces))
+	for j, in := range interfaces {
+		in.Available = Unknown
+		in.Error = ""
+		in.ErrorsFrom = ""
+		in.DisableUntil = ""
+
+		if in.Details != nil {
+			asB, _ := json.Marshal(in.Details)
+			in.RawDetails = json.RawMessage(asB)
+		}
+		res[j] = in
+	}
+	return res
+}
+
+// HostInterfacesGet Wrapper for hostinterface.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostinterface/get
+func (api *API) HostInterfacesGet(params Params) (res HostInterfaces, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("hostinterface.get", params, &res)
+	api.interfacesDetailsUnmarshal(res)
+	return
+}
+
+// HostInterfaceGetByID Gets host interface by Id only if there is exactly 1 matching interface.
+func (api *API) HostInterfaceGetByID(id string) (res *HostInterface, err error) {
+	interfaces, err := api.HostInterfacesGet(Params{"interfaceids": id})
+	if err != nil {
+		return
+	}
+
+	if len(interfaces) == 1 {
+		res = &interfaces[0]
+	} else {
+		e := ExpectedOneResult(len(interfaces))
+		err = &e
+	}
+	return
+}
+
+// HostInterfacesGetByHost Gets interfaces of the host.
+func (api *API) HostInterfacesGetByHost(host Host) (res HostInterfaces, err error) {
+	return api.HostInterfacesGet(Params{"hostids": host.HostID})
+}
+
+// HostInterfacesCreate Wrapper for hostinterface.create
+// HostID must be set in all interfaces elements.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostinterface/create
+func (api *API) HostInterfacesCreate(interfaces HostInterfaces) (err error) {
+	response, err := api.CallWithError("hostinterface.create", prepInterfaces(interfaces))
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	interfaceids := result["interfaceids"].([]interface{})
+	for i, id := range interfaceids {
+		interfaces[i].InterfaceID = id.(string)
+	}
+	return
+}
+
+// HostInterfacesUpdate Wrapper for hostinterface.update
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostinterface/update
+func (api *API) HostInterfacesUpdate(interfaces HostInterfaces) (err error) {
+	update := prepInterfaces(interfaces)
+	for i := range update {
+		// interface can't be moved to another host
+		update[i].HostID = ""
+	}
+	_, err = api.CallWithError("hostinterface.update", update)
+	return
+}
+
+// HostInterfacesReplace Wrapper for hostinterface.replacehostinterfaces
+// Replaces all interfaces of the host, InterfaceID is set in all interfaces elements.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostinterface/replacehostinterfaces
+func (api *API) HostInterfacesReplace(host Host, interfaces HostInterfaces) (err error) {
+	replace := prepInterfaces(interfaces)
+	for i := range replace {
+		replace[i].HostID = ""
+	}
+
+	response, err := api.CallWithError("hostinterface.replacehostinterfaces", Params{
+		"hostid":     host.HostID,
+		"interfaces": replace,
+	})
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	interfaceids := result["interfaceids"].([]interface{})
+	for i, id := range interfaceids {
+		interfaces[i].InterfaceID = id.(string)
+		interfaces[i].HostID = host.HostID
+	}
+	return
+}
+
+// HostInterfacesDelete Wrapper for hostinterface.delete
+// Cleans InterfaceID in all interfaces elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostinterface/delete
+func (api *API) HostInterfacesDelete(interfaces HostInterfaces) (err error) {
+	ids := make([]string, len(interfaces))
+	for i, in := range interfaces {
+		ids[i] = in.InterfaceID
+	}
+
+	err = api.HostInterfacesDeleteByIds(ids)
+	if err == nil {
+		for i := range interfaces {
+			interfaces[i].InterfaceID = ""
+		}
+	}
+	return
+}
+
+// HostInterfacesDeleteByIds Wrapper for hostinterface.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostinterface/delete
+func (api *API) HostInterfacesDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("hostinterface.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	interfaceids := result["interfaceids"].([]interface{})
+	if len(ids) != len(interfaceids) {
+		err = &ExpectedMore{len(ids), len(interfaceids)}
+	}
+	return
+}
@@ -0,0 +1,102 @@
+package zabbix_test
+
+import (
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestHostInterfaces(t *testing.T) {
+	api := getAPI(t)
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	host := CreateHost(group, t)
+	defer DeleteHost(host, t)
+
+	interfaces := zapi.HostInterfaces{{
+		HostID: host.HostID,
+		IP:     "127.0.0.1",
+		Port:   "161",
+		Type:   zapi.SNMP,
+		UseIP:  zapi.ConnectByIP,
+		Main:   zapi.InterfaceMain,
+		Details: &zapi.HostInterfaceDetail{
+			Version:   zapi.SNMPVersion2c,
+			Bulk:      "1",
+			Community: "{$SNMP_COMMUNITY}",
+		},
+	}}
+	err := api.HostInterfacesCreate(interfaces)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if interfaces[0].InterfaceID == "" {
+		t.Errorf("Interface id is empty: %#v", interfaces[0])
+	}
+
+	interfaces[0].IP = "127.0.0.2"
+	err = api.HostInterfacesUpdate(interfaces)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in, err := api.HostInterfaceGetByID(interfaces[0].InterfaceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if in.IP != "127.0.0.2" || in.HostID != host.HostID || in.Details == nil || in.Details.Community != "{$SNMP_COMMUNITY}" {
+		t.Errorf("Bad interface: %#v", in)
+	}
+
+	all, err := api.HostInterfacesGetByHost(*host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 {
+		t.Errorf("Bad interfaces: %#v", all)
+	}
+
+	err = api.HostInterfacesDelete(interfaces)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all, err = api.HostInterfacesGetByHost(*host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 {
+		t.Errorf("Bad interfaces: %#v", all)
+	}
+}
+
+func TestHostInterfacesNotChanged(t *testing.T) {
+	api := getAPI(t)
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	host := CreateHost(group, t)
+	defer DeleteHost(host, t)
+
+	hosts, err := api.HostsGet(zapi.Params{"hostids": host.HostID, "selectInterfaces": "extend"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hosts) != 1 || len(hosts[0].Interfaces) != 1 {
+		t.Fatalf("Bad hosts: %#v", hosts)
+	}
+
+	// read only fields are not sent, but are kept in the caller's interfaces
+	hosts[0].Interfaces[0].Error = "stale"
+	err = api.HostsUpdate(hosts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := hosts[0].Interfaces[0]
+	if in.HostID != host.HostID || in.Error != "stale" {
+		t.Errorf("Interface is changed: %#v", in)
+	}
+}
@@ -0,0 +1,175 @@
+package zabbix
+
+type (
+	// CustomInterfacesType source of the interfaces of hosts created from a prototype
+	// see "custom_interfaces" in https://www.zabbix.com/documentation/current/en/manual/api/reference/hostprototype/object
+	CustomInterfacesType int
+)
+
+const (
+	// InheritInterfaces interfaces are inherited from the parent host (default)
+	InheritInterfaces CustomInterfacesType = 0
+	// CustomInterfaces interfaces of the prototype are used
+	CustomInterfaces CustomInterfacesType = 1
+)
+
+// GroupPrototype represents a group prototype of a host prototype
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostprototype/object#group-prototype
+type GroupPrototype struct {
+	GroupPrototypeID string `json:"group_prototypeid,omitempty"`
+	Name             string `json:"name"`
+}
+
+// GroupPrototypes is an array of GroupPrototype
+type GroupPrototypes []GroupPrototype
+
+// HostPrototype represent Zabbix host prototype object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostprototype/object
+type HostPrototype struct {
+	HostID           string               `json:"hostid,omitempty"`
+	Host             string               `json:"host"`
+	Name             string               `json:"name,omitempty"`
+	Status           StatusType           `json:"status,string"`
+	Discover         string               `json:"discover,omitempty"`
+	InventoryMode    InventoryMode        `json:"inventory_mode,string"`
+	CustomInterfaces CustomInterfacesType `json:"custom_interfaces,string"`
+
+	// ID of the LLD rule, only used when creating host prototypes
+	RuleID string `json:"ruleid,omitempty"`
+
+	GroupLinks      HostGroupIDs    `json:"groupLinks,omitempty"`
+	GroupPrototypes GroupPrototypes `json:"groupPrototypes,omitempty"`
+	Interfaces      HostInterfaces  `json:"interfaces,omitempty"`
+	TemplateIDs     TemplateIDs     `json:"templates,omitempty"`
+	UserMacros      Macros          `json:"macros,omitempty"`
+	Tags            Tags            `json:"tags,omitempty"`
+}
+
+// HostPrototypes is an array of HostPrototype
+type HostPrototypes []HostPrototype
+
+// HostPrototypesGet Wrapper for hostprototype.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostprototype/get
+func (api *API) HostPrototypesGet(params Params) (res HostPrototypes, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("hostprototype.get", params, &res)
+	for i := range res {
+		api.interfacesDetailsUnmarshal(res[i].Interfaces)
+	}
+	return
+}
+
+// HostPrototypesGetByLLDRule Gets host prototypes of the LLD rule with their links, interfaces, macros and tags.
+func (api *API) HostPrototypesGetByLLDRule(rule LLDRule) (res HostPrototypes, err error) {
+	res, err = api.HostPrototypesGet(Params{
+		"discoveryids":          rule.ItemID,
+		"selectGroupLinks":      "extend",
+		"selectGroupPrototypes": "extend",
+		"selectInterfaces":      "extend",
+		"selectTemplates":       []string{"templateid"},
+		"selectMacros":          "extend",
+		"selectTags":            "extend",
+	})
+	for i := range res {
+		res[i].RuleID = rule.ItemID
+	}
+	return
+}
+
+// HostPrototypeGetByID Gets host prototype by Id only if there is exactly 1 matching host prototype.
+func (api *API) HostPrototypeGetByID(id string) (res *HostPrototype, err error) {
+	prototypes, err := api.HostPrototypesGet(Params{
+		"hostids":               id,
+		"selectGroupLinks":      "extend",
+		"selectGroupPrototypes": "extend",
+		"selectInterfaces":      "extend",
+		"selectTemplates":       []string{"templateid"},
+		"selectMacros":          "extend",
+		"selectTags":            "extend",
+	})
+	if err != nil {
+		return
+	}
+
+	if len(prototypes) == 1 {
+		res = &prototypes[0]
+	} else {
+		e := ExpectedOneResult(len(prototypes))
+		err = &e
+	}
+	return
+}
+
+// HostPrototypesCreate Wrapper for hostprototype.create
+// RuleID must be set to the ItemID of the LLD rule the prototypes belong to.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostprototype/create
+func (api *API) HostPrototypesCreate(prototypes HostPrototypes) (err error) {
+	create := make(HostPrototypes, len(prototypes))
+	for i, prototype := range prototypes {
+		prototype.Interfaces = prepInterfaces(prototype.Interfaces)
+		prototype.UserMacros = api.prepMacros(prototype.UserMacros)
+		create[i] = prototype
+	}
+	response, err := api.CallWithError("hostprototype.create", create)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	hostids := result["hostids"].([]interface{})
+	for i, id := range hostids {
+		prototypes[i].HostID = id.(string)
+	}
+	return
+}
+
+// HostPrototypesUpdate Wrapper for hostprototype.update
+// The LLD rule of a host prototype can't be changed, RuleID is not sent.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostprototype/update
+func (api *API) HostPrototypesUpdate(prototypes HostPrototypes) (err error) {
+	update := make(HostPrototypes, len(prototypes))
+	for i, prototype := range prototypes {
+		prototype.Interfaces = prepInterfaces(prototype.Interfaces)
+		prototype.UserMacros = api.prepMacros(prototype.UserMacros)
+		prototype.RuleID = ""
+		update[i] = prototype
+	}
+	_, err = api.CallWithError("hostprototype.update", update)
+	return
+}
+
+// HostPrototypesDelete Wrapper for hostprototype.delete
+// Cleans HostID in all prototypes elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostprototype/delete
+func (api *API) HostPrototypesDelete(prototypes HostPrototypes) (err error) {
+	ids := make([]string, len(prototypes))
+	for i, prototype := range prototypes {
+		ids[i] = prototype.HostID
+	}
+
+	err = api.HostPrototypesDeleteByIds(ids)
+	if err == nil {
+		for i := range prototypes {
+			prototypes[i].HostID = ""
+		}
+	}
+	return
+}
+
+// HostPrototypesDeleteByIds Wrapper for hostprototype.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/hostprototype/delete
+func (api *API) HostPrototypesDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("hostprototype.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	hostids := result["hostids"].([]interface{})
+	if len(ids) != len(hostids) {
+		err = &ExpectedMore{len(ids), len(hostids)}
+	}
+	return
+}
@@ -0,0 +1,66 @@
+package zabbix_test
+
+import (
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestHostPrototypes(t *testing.T) {
+	api := getAPI(t)
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	host := CreateHost(group, t)
+	defer DeleteHost(host, t)
+
+	rule := CreateLLDRule(host, t)
+	defer DeleteLLDRule(rule, t)
+
+	prototypes := zapi.HostPrototypes{{
+		Host:            "{#NAME}",
+		Name:            "Prototype {#NAME}",
+		RuleID:          rule.ItemID,
+		GroupLinks:      zapi.HostGroupIDs{{GroupID: group.GroupID}},
+		GroupPrototypes: zapi.GroupPrototypes{{Name: "Discovered {#NAME}"}},
+		Tags:            zapi.Tags{{Tag: "origin", Value: "{#NAME}"}},
+	}}
+	err := api.HostPrototypesCreate(prototypes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prototypes[0].HostID == "" {
+		t.Errorf("Id is empty: %#v", prototypes[0])
+	}
+
+	res, err := api.HostPrototypesGetByLLDRule(*rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || res[0].RuleID != rule.ItemID || len(res[0].GroupLinks) != 1 || len(res[0].GroupPrototypes) != 1 || len(res[0].Tags) != 1 {
+		t.Fatalf("Bad host prototypes: %#v", res)
+	}
+
+	res[0].Name = "Renamed {#NAME}"
+	err = api.HostPrototypesUpdate(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prototype, err := api.HostPrototypeGetByID(prototypes[0].HostID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prototype.Name != "Renamed {#NAME}" {
+		t.Errorf("Bad host prototype: %#v", prototype)
+	}
+
+	err = api.HostPrototypesDelete(prototypes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prototypes[0].HostID != "" {
+		t.Errorf("Id is not cleaned: %#v", prototypes[0])
+	}
+}
@@ -96,3 +96,91 @@ func TestHosts(t *testing.T) {
 		t.Errorf("Bad hosts: %#v", hosts)
 	}
 }
+
+func TestHostsMass(t *testing.T) {
+	api := getAPI(t)
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	var templateGroupID string
+	if api.Config.Version >= 60200 {
+		templateGroup := CreateTemplateGroup(t)
+		defer DeleteTemplateGroup(templateGroup, t)
+		templateGroupID = templateGroup.GroupID
+	} else {
+		templateGroupID = group.GroupID
+	}
+	template := CreateTemplate(templateGroupID, t)
+	defer DeleteTemplate(template, t)
+
+	host := CreateHost(group, t)
+	defer DeleteHost(host, t)
+
+	err := api.HostsMassAdd(zapi.Hosts{*host}, zapi.HostMassOptions{
+		Templates: zapi.TemplateIDs{{template.TemplateID}},
+		Macros:    zapi.Macros{{MacroName: "{$MASS}", Value: "1"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hosts, err := api.HostsGet(zapi.Params{"hostids": host.HostID, "selectParentTemplates": []string{"templateid"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hosts) != 1 || len(hosts[0].ParentTemplateIDs) != 1 || hosts[0].ParentTemplateIDs[0].TemplateID != template.TemplateID {
+		t.Errorf("Template is not linked: %#v", hosts)
+	}
+
+	err = api.HostsMassRemove(zapi.Hosts{*host}, zapi.HostMassOptions{
+		Templates: zapi.TemplateIDs{{template.TemplateID}},
+		Macros:    zapi.Macros{{MacroName: "{$MASS}"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hosts, err = api.HostsGet(zapi.Params{"hostids": host.HostID, "selectParentTemplates": []string{"templateid"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hosts) != 1 || len(hosts[0].ParentTemplateIDs) != 0 {
+		t.Errorf("Template is still linked: %#v", hosts)
+	}
+}
+
+func TestHostsGetBySearchInventory(t *testing.T) {
+	api := getAPI(t)
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	name := fmt.Sprintf("%s-%d", getHost(), rand.Int())
+	osFull := fmt.Sprintf("zabbix-testing-%d", rand.Int())
+	hosts := zapi.Hosts{{
+		Host:          name,
+		GroupIds:      zapi.HostGroupIDs{{group.GroupID}},
+		InventoryMode: zapi.InventoryManual,
+		Inventory:     zapi.HostInventory{OSFull: osFull, Location: "rack 42"}.Map(),
+	}}
+	err := api.HostsCreate(hosts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer DeleteHost(&hosts[0], t)
+	if hosts[0].RawInventoryMode != nil {
+		t.Errorf("Host is changed: %#v", hosts[0])
+	}
+
+	res, err := api.HostsGetBySearchInventory(zapi.HostInventory{OSFull: osFull}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || res[0].HostID != hosts[0].HostID || res[0].InventoryMode != zapi.InventoryManual {
+		t.Fatalf("Bad hosts: %#v", res)
+	}
+	if inv := res[0].Inventory.Typed(); inv.OSFull != osFull || inv.Location != "rack 42" {
+		t.Errorf("Bad inventory: %#v", inv)
+	}
+}
@@ -0,0 +1,64 @@
+package zabbix
+
+// Housekeeping represent Zabbix housekeeping object, available since 5.2.
+// Empty fields are not updated unless listed in HousekeepingUpdate, modes are "0" disabled and "1" enabled.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/housekeeping/object
+type Housekeeping struct {
+	EventsMode        string `json:"hk_events_mode,omitempty"`
+	EventsTrigger     string `json:"hk_events_trigger,omitempty"`
+	EventsInternal    string `json:"hk_events_internal,omitempty"`
+	EventsDiscovery   string `json:"hk_events_discovery,omitempty"`
+	EventsAutoreg     string `json:"hk_events_autoreg,omitempty"`
+	AuditMode         string `json:"hk_audit_mode,omitempty"`
+	Audit             string `json:"hk_audit,omitempty"`
+	SessionsMode      string `json:"hk_sessions_mode,omitempty"`
+	Sessions          string `json:"hk_sessions,omitempty"`
+	HistoryMode       string `json:"hk_history_mode,omitempty"`
+	HistoryGlobal     string `json:"hk_history_global,omitempty"`
+	History           string `json:"hk_history,omitempty"`
+	TrendsMode        string `json:"hk_trends_mode,omitempty"`
+	TrendsGlobal      string `json:"hk_trends_global,omitempty"`
+	Trends            string `json:"hk_trends,omitempty"`
+	CompressionStatus string `json:"compression_status,omitempty"`
+	CompressOlder     string `json:"compress_older,omitempty"`
+
+	// before 6.0
+	ServicesMode string `json:"hk_services_mode,omitempty"`
+	Services     string `json:"hk_services,omitempty"`
+
+	// since 6.0
+	EventsService string `json:"hk_events_service,omitempty"`
+
+	// read only
+	DBExtension             string `json:"db_extension,omitempty"`
+	CompressionAvailability string `json:"compression_availability,omitempty"`
+}
+
+var housekeepingVersions = map[string]fieldVersion{
+	"hk_services_mode":         {until: 60000},
+	"hk_services":              {until: 60000},
+	"hk_events_service":        {since: 60000},
+	"db_extension":             {readOnly: true},
+	"compression_availability": {readOnly: true},
+}
+
+// HousekeepingGet Wrapper for housekeeping.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/housekeeping/get
+func (api *API) HousekeepingGet() (res *Housekeeping, err error) {
+	res = &Housekeeping{}
+	err = api.CallWithErrorParse("housekeeping.get", Params{"output": "extend"}, res)
+	return
+}
+
+// HousekeepingUpdate Wrapper for housekeeping.update
+// Empty fields, read only fields and fields unsupported by the server version are not sent.
+// If JSON names of fields are given, only these fields are sent, so that they can be set to "".
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/housekeeping/update
+func (api *API) HousekeepingUpdate(housekeeping Housekeeping, fields ...string) (err error) {
+	params, err := api.versionedParams(housekeeping, housekeepingVersions, fields)
+	if err != nil {
+		return
+	}
+	_, err = api.CallWithError("housekeeping.update", params)
+	return
+}
@@ -0,0 +1,293 @@
+package zabbix
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type (
+	// HttpAuthType authentication method of a web scenario
+	// see "authentication" in https://www.zabbix.com/documentation/current/en/manual/api/reference/httptest/object
+	HttpAuthType int
+
+	// HttpPostType type of the post data of a scenario step
+	HttpPostType int
+)
+
+const (
+	// HttpAuthNone no authentication (default)
+	HttpAuthNone HttpAuthType = 0
+	// HttpAuthBasic basic authentication
+	HttpAuthBasic HttpAuthType = 1
+	// HttpAuthNTLM NTLM authentication
+	HttpAuthNTLM HttpAuthType = 2
+	// HttpAuthKerberos Kerberos authentication
+	HttpAuthKerberos HttpAuthType = 3
+	// HttpAuthDigest Digest authentication
+	HttpAuthDigest HttpAuthType = 4
+)
+
+const (
+	// HttpPostForm form data, sent from PostFields (default)
+	HttpPostForm HttpPostType = 0
+	// HttpPostRaw raw data, sent from Posts
+	HttpPostRaw HttpPostType = 1
+)
+
+// HttpField represents name/value pair used by web scenarios for variables,
+// headers, query fields and form data.
+type HttpField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// HttpFields is an array of HttpField
+type HttpFields []HttpField
+
+// HttpStep represent Zabbix web scenario step object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/httptest/object#scenario-step
+type HttpStep struct {
+	HttpStepID      string     `json:"httpstepid,omitempty"`
+	HttpTestID      string     `json:"httptestid,omitempty"`
+	Name            string     `json:"name"`
+	No              int        `json:"no,string"`
+	Url             string     `json:"url"`
+	QueryFields     HttpFields `json:"query_fields,omitempty"`
+	Variables       HttpFields `json:"variables,omitempty"`
+	Headers         HttpFields `json:"headers,omitempty"`
+	FollowRedirects string     `json:"follow_redirects,omitempty"`
+	RetrieveMode    string     `json:"retrieve_mode,omitempty"`
+	Required        string     `json:"required,omitempty"`
+	StatusCodes     string     `json:"status_codes,omitempty"`
+	Timeout         string     `json:"timeout,omitempty"`
+
+	// post data is a string for raw posts and an array of fields for forms
+	PostType   HttpPostType    `json:"post_type,string"`
+	RawPosts   json.RawMessage `json:"posts,omitempty"`
+	Posts      string          `json:"-"`
+	PostFields HttpFields      `json:"-"`
+}
+
+// HttpSteps is an array of HttpStep
+type HttpSteps []HttpStep
+
+// HttpTest represent Zabbix web scenario object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/httptest/object
+type HttpTest struct {
+	HttpTestID string     `json:"httptestid,omitempty"`
+	HostID     string     `json:"hostid,omitempty"`
+	Name       string     `json:"name"`
+	Delay      string     `json:"delay,omitempty"`
+	Retries    string     `json:"retries,omitempty"`
+	Agent      string     `json:"agent,omitempty"`
+	Proxy      string     `json:"http_proxy,omitempty"`
+	Status     StatusType `json:"status,string"`
+	TemplateID string     `json:"templateid,omitempty"`
+	Variables  HttpFields `json:"variables,omitempty"`
+	Headers    HttpFields `json:"headers,omitempty"`
+
+	Authentication HttpAuthType `json:"authentication,string"`
+	HttpUser       string       `json:"http_user,omitempty"`
+	HttpPassword   string       `json:"http_password,omitempty"`
+
+	// SSL options
+	VerifyPeer     string `json:"verify_peer,omitempty"`
+	VerifyHost     string `json:"verify_host,omitempty"`
+	SSLCertFile    string `json:"ssl_cert_file,omitempty"`
+	SSLKeyFile     string `json:"ssl_key_file,omitempty"`
+	SSLKeyPassword string `json:"ssl_key_password,omitempty"`
+
+	Steps HttpSteps `json:"steps,omitempty"`
+	Tags  Tags      `json:"tags,omitempty"`
+}
+
+// HttpTests is an array of HttpTest
+type HttpTests []HttpTest
+
+// quoteItemKeyParam quotes item key parameter the same way the Zabbix frontend does.
+func quoteItemKeyParam(param string) string {
+	if param == "" || (param[0] != '"' && param[0] != ' ' && !strings.ContainsAny(param, ",]")) {
+		return param
+	}
+	return `"` + strings.ReplaceAll(param, `"`, `\"`) + `"`
+}
+
+// ItemKeys returns keys of the items Zabbix generates for the scenario and each of its steps.
+func (t HttpTest) ItemKeys() (keys []string) {
+	name := quoteItemKeyParam(t.Name)
+	keys = []string{
+		"web.test.in[" + name + ",,bps]",
+		"web.test.fail[" + name + "]",
+		"web.test.error[" + name + "]",
+	}
+	for _, step := range t.Steps {
+		s := name + "," + quoteItemKeyParam(step.Name)
+		keys = append(keys,
+			"web.test.in["+s+",bps]",
+			"web.test.time["+s+",resp]",
+			"web.test.rspcode["+s+"]",
+		)
+	}
+	return
+}
+
+func httpTestsPostsUnmarshal(tests HttpTests) (err error) {
+	for i := 0; i < len(tests); i++ {
+		for j := 0; j < len(tests[i].Steps); j++ {
+			step := &tests[i].Steps[j]
+			step.Posts = ""
+			step.PostFields = nil
+
+			if len(step.RawPosts) == 0 {
+				continue
+			}
+
+			asStr := string(step.RawPosts)
+			if asStr == "[]" || asStr == `""` {
+				continue
+			}
+
+			if step.RawPosts[0] == '[' {
+				err = json.Unmarshal(step.RawPosts, &step.PostFields)
+			} else {
+				err = json.Unmarshal(step.RawPosts, &step.Posts)
+			}
+			if err != nil {
+				return fmt.Errorf("unexpected posts of web scenario step %q: %s", step.Name, err)
+			}
+		}
+	}
+	return
+}
+
+// prepHttpTests returns copies of the tests with posts of the steps ready to be sent.
+func prepHttpTests(tests HttpTests) HttpTests {
+	res := make(HttpTests, len(tests))
+	for i, test := range tests {
+		if test.Steps != nil {
+			steps := make(HttpSteps, len(test.Steps))
+			for j, step := range test.Steps {
+				// posts read by a get are replaced by Posts or PostFields
+				step.RawPosts = nil
+
+				var asB []byte
+				if step.PostType == HttpPostRaw {
+					if step.Posts != "" {
+						asB, _ = json.Marshal(step.Posts)
+					}
+				} else if step.PostFields != nil {
+					asB, _ = json.Marshal(step.PostFields)
+				}
+				if asB != nil {
+					step.RawPosts = json.RawMessage(asB)
+				}
+				steps[j] = step
+			}
+			test.Steps = steps
+		}
+		res[i] = test
+	}
+	return res
+}
+
+// HttpTestsGet Wrapper for httptest.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/httptest/get
+func (api *API) HttpTestsGet(params Params) (res HttpTests, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("httptest.get", params, &res)
+	if err != nil {
+		return
+	}
+	err = httpTestsPostsUnmarshal(res)
+	return
+}
+
+// HttpTestGetByID Gets web scenario with its steps and tags by Id only if there is exactly 1 matching web scenario.
+func (api *API) HttpTestGetByID(id string) (res *HttpTest, err error) {
+	tests, err := api.HttpTestsGet(Params{
+		"httptestids": id,
+		"selectSteps": "extend",
+		"selectTags":  "extend",
+	})
+	if err != nil {
+		return
+	}
+
+	if len(tests) == 1 {
+		res = &tests[0]
+	} else {
+		e := ExpectedOneResult(len(tests))
+		err = &e
+	}
+	return
+}
+
+// HttpTestItemsGet Gets the web.test.* items Zabbix generated for the scenario.
+// Steps of the scenario must be filled to get the per step items.
+func (api *API) HttpTestItemsGet(test HttpTest) (res Items, err error) {
+	return api.ItemsGet(Params{
+		"hostids":  test.HostID,
+		"webitems": true,
+		"filter":   map[string]interface{}{"key_": test.ItemKeys()},
+	})
+}
+
+// HttpTestsCreate Wrapper for httptest.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/httptest/create
+func (api *API) HttpTestsCreate(tests HttpTests) (err error) {
+	response, err := api.CallWithError("httptest.create", prepHttpTests(tests))
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	httptestids := result["httptestids"].([]interface{})
+	for i, id := range httptestids {
+		tests[i].HttpTestID = id.(string)
+	}
+	return
+}
+
+// HttpTestsUpdate Wrapper for httptest.update
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/httptest/update
+func (api *API) HttpTestsUpdate(tests HttpTests) (err error) {
+	_, err = api.CallWithError("httptest.update", prepHttpTests(tests))
+	return
+}
+
+// HttpTestsDelete Wrapper for httptest.delete
+// Cleans HttpTestID in all tests elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/httptest/delete
+func (api *API) HttpTestsDelete(tests HttpTests) (err error) {
+	ids := make([]string, len(tests))
+	for i, test := range tests {
+		ids[i] = test.HttpTestID
+	}
+
+	err = api.HttpTestsDeleteByIds(ids)
+	if err == nil {
+		for i := range tests {
+			tests[i].HttpTestID = ""
+		}
+	}
+	return
+}
+
+// HttpTestsDeleteByIds Wrapper for httptest.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/httptest/delete
+func (api *API) HttpTestsDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("httptest.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	httptestids := result["httptestids"].([]interface{})
+	if len(ids) != len(httptestids) {
+		err = &ExpectedMore{len(ids), len(httptestids)}
+	}
+	return
+}
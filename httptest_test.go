@@ -0,0 +1,69 @@
+package zabbix_test
+
+import (
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func CreateHttpTest(host *zapi.Host, t *testing.T) *zapi.HttpTest {
+	tests := zapi.HttpTests{{
+		HostID: host.HostID,
+		Name:   "Homepage, check",
+		Steps: zapi.HttpSteps{{
+			Name:        "Login",
+			No:          1,
+			Url:         "http://localhost/index.php",
+			StatusCodes: "200",
+			PostFields:  zapi.HttpFields{{Name: "name", Value: "Admin"}},
+		}},
+	}}
+	err := getAPI(t).HttpTestsCreate(tests)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tests[0].Steps[0].RawPosts != nil {
+		t.Errorf("Steps are changed: %#v", tests[0].Steps[0])
+	}
+	return &tests[0]
+}
+
+func DeleteHttpTest(test *zapi.HttpTest, t *testing.T) {
+	err := getAPI(t).HttpTestsDelete(zapi.HttpTests{*test})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHttpTests(t *testing.T) {
+	api := getAPI(t)
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	host := CreateHost(group, t)
+	defer DeleteHost(host, t)
+
+	test := CreateHttpTest(host, t)
+	if test.HttpTestID == "" {
+		t.Errorf("Id is empty: %#v", test)
+	}
+
+	test2, err := api.HttpTestGetByID(test.HttpTestID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(test2.Steps) != 1 || len(test2.Steps[0].PostFields) != 1 {
+		t.Errorf("Bad steps: %#v", test2.Steps)
+	}
+
+	items, err := api.HttpTestItemsGet(*test2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != len(test2.ItemKeys()) {
+		t.Errorf("Expected %d web items, got %#v", len(test2.ItemKeys()), items)
+	}
+
+	DeleteHttpTest(test, t)
+}
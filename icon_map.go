@@ -0,0 +1,142 @@
+package zabbix
+
+// IconMapping represent Zabbix icon mapping of an icon map
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/iconmap/object#icon-mapping
+type IconMapping struct {
+	IconMappingID string `json:"iconmappingid,omitempty"`
+	IconID        string `json:"iconid"`
+	// Expression matched against the inventory field, may reference a global regexp as "@name"
+	Expression string `json:"expression"`
+	// InventoryLink index of the inventory field, see InventoryFieldName
+	InventoryLink int `json:"inventory_link,string"`
+	// SortOrder read only, position of the mapping in the map
+	SortOrder string `json:"sortorder,omitempty"`
+}
+
+// IconMappings is an array of IconMapping
+type IconMappings []IconMapping
+
+// NewIconMapping returns mapping of the inventory field name, e.g. "os_short", to the icon.
+// Link is 0 for unknown fields and the API rejects the mapping.
+func NewIconMapping(field, expression, iconID string) IconMapping {
+	return IconMapping{IconID: iconID, Expression: expression, InventoryLink: InventoryLink(field)}
+}
+
+// InventoryField returns name of the inventory field matched by the mapping.
+func (m IconMapping) InventoryField() string {
+	return InventoryFieldName(m.InventoryLink)
+}
+
+// IconMap represent Zabbix icon map object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/iconmap/object
+type IconMap struct {
+	IconMapID     string       `json:"iconmapid,omitempty"`
+	Name          string       `json:"name"`
+	DefaultIconID string       `json:"default_iconid"`
+	Mappings      IconMappings `json:"mappings,omitempty"`
+}
+
+// IconMaps is an array of IconMap
+type IconMaps []IconMap
+
+// prepIconMaps returns copies of the icon maps without read only fields of mappings.
+func prepIconMaps(iconMaps IconMaps) IconMaps {
+	res := make(IconMaps, len(iconMaps))
+	for i, iconMap := range iconMaps {
+		if iconMap.Mappings != nil {
+			mappings := make(IconMappings, len(iconMap.Mappings))
+			for j, m := range iconMap.Mappings {
+				m.IconMappingID = ""
+				m.SortOrder = ""
+				mappings[j] = m
+			}
+			iconMap.Mappings = mappings
+		}
+		res[i] = iconMap
+	}
+	return res
+}
+
+// IconMapsGet Wrapper for iconmap.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/iconmap/get
+func (api *API) IconMapsGet(params Params) (res IconMaps, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("iconmap.get", params, &res)
+	return
+}
+
+// IconMapGetByID Gets icon map with its mappings by Id only if there is exactly 1 matching icon map.
+func (api *API) IconMapGetByID(id string) (res *IconMap, err error) {
+	iconMaps, err := api.IconMapsGet(Params{"iconmapids": id, "selectMappings": "extend"})
+	if err != nil {
+		return
+	}
+
+	if len(iconMaps) == 1 {
+		res = &iconMaps[0]
+	} else {
+		e := ExpectedOneResult(len(iconMaps))
+		err = &e
+	}
+	return
+}
+
+// IconMapsCreate Wrapper for iconmap.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/iconmap/create
+func (api *API) IconMapsCreate(iconMaps IconMaps) (err error) {
+	response, err := api.CallWithError("iconmap.create", prepIconMaps(iconMaps))
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	iconmapids := result["iconmapids"].([]interface{})
+	for i, id := range iconmapids {
+		iconMaps[i].IconMapID = id.(string)
+	}
+	return
+}
+
+// IconMapsUpdate Wrapper for iconmap.update
+// Mappings, if set, replace existing ones in their order.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/iconmap/update
+func (api *API) IconMapsUpdate(iconMaps IconMaps) (err error) {
+	_, err = api.CallWithError("iconmap.update", prepIconMaps(iconMaps))
+	return
+}
+
+// IconMapsDelete Wrapper for iconmap.delete
+// Cleans IconMapID in all icon maps elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/iconmap/delete
+func (api *API) IconMapsDelete(iconMaps IconMaps) (err error) {
+	ids := make([]string, len(iconMaps))
+	for i, iconMap := range iconMaps {
+		ids[i] = iconMap.IconMapID
+	}
+
+	err = api.IconMapsDeleteByIds(ids)
+	if err == nil {
+		for i := range iconMaps {
+			iconMaps[i].IconMapID = ""
+		}
+	}
+	return
+}
+
+// IconMapsDeleteByIds Wrapper for iconmap.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/iconmap/delete
+func (api *API) IconMapsDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("iconmap.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	iconmapids := result["iconmapids"].([]interface{})
+	if len(ids) != len(iconmapids) {
+		err = &ExpectedMore{len(ids), len(iconmapids)}
+	}
+	return
+}
@@ -0,0 +1,113 @@
+package zabbix
+
+// ImageType type of the image
+// see "imagetype" in https://www.zabbix.com/documentation/current/en/manual/api/reference/image/object
+type ImageType int
+
+const (
+	ImageIcon       ImageType = 1
+	ImageBackground ImageType = 2
+)
+
+// Image represent Zabbix image object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/image/object
+type Image struct {
+	ImageID string    `json:"imageid,omitempty"`
+	Name    string    `json:"name"`
+	Type    ImageType `json:"imagetype,omitempty,string"`
+	// Image raw PNG bytes, base64 encoded on the wire
+	Image []byte `json:"image,omitempty"`
+}
+
+// Images is an array of Image
+type Images []Image
+
+// ImagesGet Wrapper for image.get
+// Image bytes are returned with "select_image" parameter only.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/image/get
+func (api *API) ImagesGet(params Params) (res Images, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("image.get", params, &res)
+	return
+}
+
+// ImageGetByID Gets image with its bytes by Id only if there is exactly 1 matching image.
+func (api *API) ImageGetByID(id string) (res *Image, err error) {
+	images, err := api.ImagesGet(Params{"imageids": id, "select_image": true})
+	if err != nil {
+		return
+	}
+
+	if len(images) == 1 {
+		res = &images[0]
+	} else {
+		e := ExpectedOneResult(len(images))
+		err = &e
+	}
+	return
+}
+
+// ImagesCreate Wrapper for image.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/image/create
+func (api *API) ImagesCreate(images Images) (err error) {
+	response, err := api.CallWithError("image.create", images)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	imageids := result["imageids"].([]interface{})
+	for i, id := range imageids {
+		images[i].ImageID = id.(string)
+	}
+	return
+}
+
+// ImagesUpdate Wrapper for image.update
+// Image type can't be changed and is not sent, empty Image keeps the current bytes.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/image/update
+func (api *API) ImagesUpdate(images Images) (err error) {
+	update := make(Images, len(images))
+	for i, image := range images {
+		image.Type = 0
+		update[i] = image
+	}
+	_, err = api.CallWithError("image.update", update)
+	return
+}
+
+// ImagesDelete Wrapper for image.delete
+// Cleans ImageID in all images elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/image/delete
+func (api *API) ImagesDelete(images Images) (err error) {
+	ids := make([]string, len(images))
+	for i, image := range images {
+		ids[i] = image.ImageID
+	}
+
+	err = api.ImagesDeleteByIds(ids)
+	if err == nil {
+		for i := range images {
+			images[i].ImageID = ""
+		}
+	}
+	return
+}
+
+// ImagesDeleteByIds Wrapper for image.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/image/delete
+func (api *API) ImagesDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("image.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	imageids := result["imageids"].([]interface{})
+	if len(ids) != len(imageids) {
+		err = &ExpectedMore{len(ids), len(imageids)}
+	}
+	return
+}
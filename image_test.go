@@ -0,0 +1,68 @@
+package zabbix_test
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"math/rand"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestImagesAndIconMaps(t *testing.T) {
+	api := getAPI(t)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatal(err)
+	}
+
+	images := zapi.Images{{
+		Name:  fmt.Sprintf("%s-%d", getHost(), rand.Int()),
+		Type:  zapi.ImageIcon,
+		Image: buf.Bytes(),
+	}}
+	err := api.ImagesCreate(images)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer api.ImagesDelete(images)
+
+	img, err := api.ImageGetByID(images[0].ImageID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(img.Image, buf.Bytes()) {
+		t.Errorf("Image bytes differ: %v", img.Image)
+	}
+
+	iconMaps := zapi.IconMaps{{
+		Name:          fmt.Sprintf("%s-%d", getHost(), rand.Int()),
+		DefaultIconID: img.ImageID,
+		Mappings:      zapi.IconMappings{zapi.NewIconMapping("os_short", "^Linux", img.ImageID)},
+	}}
+	err = api.IconMapsCreate(iconMaps)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	iconMap, err := api.IconMapGetByID(iconMaps[0].IconMapID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(iconMap.Mappings) != 1 || iconMap.Mappings[0].InventoryField() != "os_short" {
+		t.Errorf("Bad mappings: %#v", iconMap.Mappings)
+	}
+
+	err = api.IconMapsUpdate(zapi.IconMaps{*iconMap})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = api.IconMapsDelete(iconMaps)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,4 +1,227 @@
 package zabbix
 
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// Inventory legacy map of host inventory fields keyed by field name
 // https://www.zabbix.com/documentation/5.0/manual/api/reference/host/object#host_inventory
 type Inventory map[string]string
+
+// HostInventory represent Zabbix host inventory with the standard fields
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/host/object#host-inventory
+type HostInventory struct {
+	Type             string `json:"type,omitempty"`
+	TypeFull         string `json:"type_full,omitempty"`
+	Name             string `json:"name,omitempty"`
+	Alias            string `json:"alias,omitempty"`
+	OS               string `json:"os,omitempty"`
+	OSFull           string `json:"os_full,omitempty"`
+	OSShort          string `json:"os_short,omitempty"`
+	SerialNoA        string `json:"serialno_a,omitempty"`
+	SerialNoB        string `json:"serialno_b,omitempty"`
+	Tag              string `json:"tag,omitempty"`
+	AssetTag         string `json:"asset_tag,omitempty"`
+	MACAddressA      string `json:"macaddress_a,omitempty"`
+	MACAddressB      string `json:"macaddress_b,omitempty"`
+	Hardware         string `json:"hardware,omitempty"`
+	HardwareFull     string `json:"hardware_full,omitempty"`
+	Software         string `json:"software,omitempty"`
+	SoftwareFull     string `json:"software_full,omitempty"`
+	SoftwareAppA     string `json:"software_app_a,omitempty"`
+	SoftwareAppB     string `json:"software_app_b,omitempty"`
+	SoftwareAppC     string `json:"software_app_c,omitempty"`
+	SoftwareAppD     string `json:"software_app_d,omitempty"`
+	SoftwareAppE     string `json:"software_app_e,omitempty"`
+	Contact          string `json:"contact,omitempty"`
+	Location         string `json:"location,omitempty"`
+	LocationLat      string `json:"location_lat,omitempty"`
+	LocationLon      string `json:"location_lon,omitempty"`
+	Notes            string `json:"notes,omitempty"`
+	Chassis          string `json:"chassis,omitempty"`
+	Model            string `json:"model,omitempty"`
+	HWArch           string `json:"hw_arch,omitempty"`
+	Vendor           string `json:"vendor,omitempty"`
+	ContractNumber   string `json:"contract_number,omitempty"`
+	InstallerName    string `json:"installer_name,omitempty"`
+	DeploymentStatus string `json:"deployment_status,omitempty"`
+	URLA             string `json:"url_a,omitempty"`
+	URLB             string `json:"url_b,omitempty"`
+	URLC             string `json:"url_c,omitempty"`
+	HostNetworks     string `json:"host_networks,omitempty"`
+	HostNetmask      string `json:"host_netmask,omitempty"`
+	HostRouter       string `json:"host_router,omitempty"`
+	OOBIP            string `json:"oob_ip,omitempty"`
+	OOBNetmask       string `json:"oob_netmask,omitempty"`
+	OOBRouter        string `json:"oob_router,omitempty"`
+	DateHWPurchase   string `json:"date_hw_purchase,omitempty"`
+	DateHWInstall    string `json:"date_hw_install,omitempty"`
+	DateHWExpiry     string `json:"date_hw_expiry,omitempty"`
+	DateHWDecomm     string `json:"date_hw_decomm,omitempty"`
+	SiteAddressA     string `json:"site_address_a,omitempty"`
+	SiteAddressB     string `json:"site_address_b,omitempty"`
+	SiteAddressC     string `json:"site_address_c,omitempty"`
+	SiteCity         string `json:"site_city,omitempty"`
+	SiteState        string `json:"site_state,omitempty"`
+	SiteCountry      string `json:"site_country,omitempty"`
+	SiteZIP          string `json:"site_zip,omitempty"`
+	SiteRack         string `json:"site_rack,omitempty"`
+	SiteNotes        string `json:"site_notes,omitempty"`
+	POC1Name         string `json:"poc_1_name,omitempty"`
+	POC1Email        string `json:"poc_1_email,omitempty"`
+	POC1PhoneA       string `json:"poc_1_phone_a,omitempty"`
+	POC1PhoneB       string `json:"poc_1_phone_b,omitempty"`
+	POC1Cell         string `json:"poc_1_cell,omitempty"`
+	POC1Screen       string `json:"poc_1_screen,omitempty"`
+	POC1Notes        string `json:"poc_1_notes,omitempty"`
+	POC2Name         string `json:"poc_2_name,omitempty"`
+	POC2Email        string `json:"poc_2_email,omitempty"`
+	POC2PhoneA       string `json:"poc_2_phone_a,omitempty"`
+	POC2PhoneB       string `json:"poc_2_phone_b,omitempty"`
+	POC2Cell         string `json:"poc_2_cell,omitempty"`
+	POC2Screen       string `json:"poc_2_screen,omitempty"`
+	POC2Notes        string `json:"poc_2_notes,omitempty"`
+}
+
+// InventoryFields names of the inventory fields indexed by inventory_link of items, index 0 is unused.
+var InventoryFields = [...]string{
+	"",
+	"type",
+	"type_full",
+	"name",
+	"alias",
+	"os",
+	"os_full",
+	"os_short",
+	"serialno_a",
+	"serialno_b",
+	"tag",
+	"asset_tag",
+	"macaddress_a",
+	"macaddress_b",
+	"hardware",
+	"hardware_full",
+	"software",
+	"software_full",
+	"software_app_a",
+	"software_app_b",
+	"software_app_c",
+	"software_app_d",
+	"software_app_e",
+	"contact",
+	"location",
+	"location_lat",
+	"location_lon",
+	"notes",
+	"chassis",
+	"model",
+	"hw_arch",
+	"vendor",
+	"contract_number",
+	"installer_name",
+	"deployment_status",
+	"url_a",
+	"url_b",
+	"url_c",
+	"host_networks",
+	"host_netmask",
+	"host_router",
+	"oob_ip",
+	"oob_netmask",
+	"oob_router",
+	"date_hw_purchase",
+	"date_hw_install",
+	"date_hw_expiry",
+	"date_hw_decomm",
+	"site_address_a",
+	"site_address_b",
+	"site_address_c",
+	"site_city",
+	"site_state",
+	"site_country",
+	"site_zip",
+	"site_rack",
+	"site_notes",
+	"poc_1_name",
+	"poc_1_email",
+	"poc_1_phone_a",
+	"poc_1_phone_b",
+	"poc_1_cell",
+	"poc_1_screen",
+	"poc_1_notes",
+	"poc_2_name",
+	"poc_2_email",
+	"poc_2_phone_a",
+	"poc_2_phone_b",
+	"poc_2_cell",
+	"poc_2_screen",
+	"poc_2_notes",
+}
+
+// InventoryFieldName returns name of the inventory field populated by items with the inventory_link,
+// empty string is returned for 0 and unknown links.
+func InventoryFieldName(link int) string {
+	if link <= 0 || link >= len(InventoryFields) {
+		return ""
+	}
+	return InventoryFields[link]
+}
+
+// InventoryLink returns inventory_link index of the field name, 0 if the field is unknown.
+func InventoryLink(name string) int {
+	for i := 1; i < len(InventoryFields); i++ {
+		if InventoryFields[i] == name {
+			return i
+		}
+	}
+	return 0
+}
+
+// Typed converts the map to HostInventory, unknown fields are dropped.
+func (inv Inventory) Typed() (res HostInventory) {
+	asB, _ := json.Marshal(inv)
+	_ = json.Unmarshal(asB, &res)
+	return
+}
+
+// Map converts the inventory to the legacy map, empty fields are omitted.
+func (inv HostInventory) Map() Inventory {
+	res := Inventory{}
+	asB, _ := json.Marshal(inv)
+	_ = json.Unmarshal(asB, &res)
+	return res
+}
+
+// inventoryUnmarshal reads inventory returned by the API.
+// Empty inventory comes as an array, non string values are converted and nulls are skipped.
+func inventoryUnmarshal(raw json.RawMessage) (Inventory, error) {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		var empty []interface{}
+		if json.Unmarshal(raw, &empty) == nil && len(empty) == 0 {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	inv := make(Inventory, len(fields))
+	for name, value := range fields {
+		switch v := value.(type) {
+		case nil:
+		case string:
+			inv[name] = v
+		case float64:
+			inv[name] = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			inv[name] = strconv.FormatBool(v)
+		default:
+			asB, _ := json.Marshal(v)
+			inv[name] = string(asB)
+		}
+	}
+	return inv, nil
+}
@@ -0,0 +1,38 @@
+package zabbix_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestInventoryFields(t *testing.T) {
+	typ := reflect.TypeOf(zapi.HostInventory{})
+	if typ.NumField() != 70 || len(zapi.InventoryFields) != 71 {
+		t.Fatalf("Bad number of fields: %d, %d", typ.NumField(), len(zapi.InventoryFields))
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		name := strings.Split(typ.Field(i).Tag.Get("json"), ",")[0]
+		if zapi.InventoryFieldName(i+1) != name || zapi.InventoryLink(name) != i+1 {
+			t.Errorf("Field %d is %s, expected %s", i+1, zapi.InventoryFieldName(i+1), name)
+		}
+	}
+	if zapi.InventoryFieldName(0) != "" || zapi.InventoryFieldName(71) != "" || zapi.InventoryLink("osfull") != 0 {
+		t.Error("Unknown fields must not be mapped")
+	}
+}
+
+func TestInventoryConversion(t *testing.T) {
+	legacy := zapi.Inventory{"os_full": "Linux 6.1", "poc_1_email": "ops@example.com", "hostid": "10084"}
+	inv := legacy.Typed()
+	if inv.OSFull != "Linux 6.1" || inv.POC1Email != "ops@example.com" {
+		t.Errorf("Bad inventory: %#v", inv)
+	}
+
+	expected := zapi.Inventory{"os_full": "Linux 6.1", "poc_1_email": "ops@example.com"}
+	if m := inv.Map(); !reflect.DeepEqual(m, expected) {
+		t.Errorf("Bad map: %#v", m)
+	}
+}
@@ -124,6 +124,12 @@ type Item struct {
 	Trends       string    `json:"trends,omitempty"`
 	TrapperHosts string    `json:"trapper_hosts,omitempty"`
 	Params       string    `json:"params,omitempty"`
+	// ValueMapID ID of the value map of the host or template, since 5.4
+	ValueMapID string `json:"valuemapid,omitempty"`
+	// InventoryLink index of the host inventory field populated by the item, see InventoryFieldName,
+	// always sent by item.create and item.update, 0 unlinks the field. Item prototypes don't have it.
+	RawInventoryLink *int `json:"inventory_link,string,omitempty"`
+	InventoryLink    int  `json:"-"`
 
 	// list of strings on set, but list of objects on get
 	RawApplications json.RawMessage `json:"applications,omitempty"`
@@ -205,6 +211,12 @@ func (api *API) ItemsGet(params Params) (res Items, err error) {
 	}
 	err = api.CallWithErrorParse("item.get", params, &res)
 	api.itemsHeadersUnmarshal(res)
+	for i := range res {
+		if res[i].RawInventoryLink != nil {
+			res[i].InventoryLink = *res[i].RawInventoryLink
+			res[i].RawInventoryLink = nil
+		}
+	}
 	return
 }
 func (api *API) ProtoItemsGet(params Params) (res Items, err error) {
@@ -275,6 +287,17 @@ func prepItems(item Items) {
 	}
 }
 
+// itemsWithInventoryLink returns copies of the items sending InventoryLink
+func itemsWithInventoryLink(items Items) Items {
+	res := make(Items, len(items))
+	for i, item := range items {
+		link := item.InventoryLink
+		item.RawInventoryLink = &link
+		res[i] = item
+	}
+	return res
+}
+
 // ItemGetByID Gets item by Id only if there is exactly 1 matching host.
 func (api *API) ItemGetByID(id string) (res *Item, err error) {
 	items, err := api.ItemsGet(Params{"itemids": id})
@@ -317,7 +340,7 @@ func (api *API) ProtoItemsGetByApplicationID(id string) (res Items, err error) {
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/item/create
 func (api *API) ItemsCreate(items Items) (err error) {
 	prepItems(items)
-	response, err := api.CallWithError("item.create", items)
+	response, err := api.CallWithError("item.create", itemsWithInventoryLink(items))
 	if err != nil {
 		return
 	}
@@ -348,7 +371,7 @@ func (api *API) ProtoItemsCreate(items Items) (err error) {
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/item/update
 func (api *API) ItemsUpdate(items Items) (err error) {
 	prepItems(items)
-	_, err = api.CallWithError("item.update", items)
+	_, err = api.CallWithError("item.update", itemsWithInventoryLink(items))
 	return
 }
 func (api *API) ProtoItemsUpdate(items Items) (err error) {
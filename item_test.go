@@ -55,10 +55,33 @@ func TestItems(t *testing.T) {
 	}
 
 	item.Name = "another name"
+	item.InventoryLink = zapi.InventoryLink("os_full")
 	err = api.ItemsUpdate(zapi.Items{*item})
 	if err != nil {
 		t.Error(err)
 	}
 
+	item2, err := api.ItemGetByID(item.ItemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item2.InventoryLink != item.InventoryLink || item2.RawInventoryLink != nil {
+		t.Errorf("Bad inventory link: %#v", item2)
+	}
+
+	// zero unlinks the field
+	item.InventoryLink = 0
+	err = api.ItemsUpdate(zapi.Items{*item})
+	if err != nil {
+		t.Error(err)
+	}
+	item2, err = api.ItemGetByID(item.ItemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item2.InventoryLink != 0 {
+		t.Errorf("Inventory link is not cleared: %#v", item2)
+	}
+
 	DeleteItem(item, t)
 }
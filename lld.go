@@ -5,15 +5,43 @@ import "encoding/json"
 type (
 	LLDEvalType     string
 	LLDOperatorType string
+
+	// LLDOverrideStopType whether the following overrides are processed
+	LLDOverrideStopType string
+	// LLDOverrideObjectType type of the prototypes an override operation applies to
+	LLDOverrideObjectType string
+	// LLDOverrideOperatorType how an override operation matches prototype names
+	LLDOverrideOperatorType string
+)
+
+const (
+	LLDAndOr     LLDEvalType     = "0"
+	LLDAnd       LLDEvalType     = "1"
+	LLDOr        LLDEvalType     = "2"
+	LLDCustom    LLDEvalType     = "3"
+	LLDMatch     LLDOperatorType = "8"
+	LLDNotMatch  LLDOperatorType = "9"
+	LLDExists    LLDOperatorType = "12"
+	LLDNotExists LLDOperatorType = "13"
 )
 
 const (
-	LLDAndOr    LLDEvalType     = "0"
-	LLDAnd      LLDEvalType     = "1"
-	LLDOr       LLDEvalType     = "2"
-	LLDCustom   LLDEvalType     = "3"
-	LLDMatch    LLDOperatorType = "8"
-	LLDNotMatch LLDOperatorType = "9"
+	// see https://www.zabbix.com/documentation/current/en/manual/api/reference/discoveryrule/object#lld-rule-overrides
+
+	LLDOverrideContinue LLDOverrideStopType = "0"
+	LLDOverrideStop     LLDOverrideStopType = "1"
+
+	LLDOverrideItemPrototype    LLDOverrideObjectType = "0"
+	LLDOverrideTriggerPrototype LLDOverrideObjectType = "1"
+	LLDOverrideGraphPrototype   LLDOverrideObjectType = "2"
+	LLDOverrideHostPrototype    LLDOverrideObjectType = "3"
+
+	LLDOverrideEquals      LLDOverrideOperatorType = "0"
+	LLDOverrideNotEquals   LLDOverrideOperatorType = "1"
+	LLDOverrideContains    LLDOverrideOperatorType = "2"
+	LLDOverrideNotContains LLDOverrideOperatorType = "3"
+	LLDOverrideMatches     LLDOverrideOperatorType = "8"
+	LLDOverrideNotMatches  LLDOverrideOperatorType = "9"
 )
 
 type LLDRuleFilterCondition struct {
@@ -32,6 +60,73 @@ type LLDRuleFilter struct {
 	Formula     string                  `json:"formula"`
 }
 
+// LLDOverrideOpStatus sets the status of matched prototypes
+type LLDOverrideOpStatus struct {
+	Status StatusType `json:"status,string"`
+}
+
+// LLDOverrideOpDiscover sets whether matched prototypes are discovered, "0" discover, "1" don't discover
+type LLDOverrideOpDiscover struct {
+	Discover string `json:"discover"`
+}
+
+// LLDOverrideOpPeriod sets the update interval of matched item prototypes
+type LLDOverrideOpPeriod struct {
+	Delay string `json:"delay"`
+}
+
+// LLDOverrideOpHistory sets the history storage period of matched item prototypes
+type LLDOverrideOpHistory struct {
+	History string `json:"history"`
+}
+
+// LLDOverrideOpTrends sets the trends storage period of matched item prototypes
+type LLDOverrideOpTrends struct {
+	Trends string `json:"trends"`
+}
+
+// LLDOverrideOpSeverity sets the severity of matched trigger prototypes
+type LLDOverrideOpSeverity struct {
+	Severity SeverityType `json:"severity,string"`
+}
+
+// LLDOverrideOpInventory sets the inventory mode of matched host prototypes
+type LLDOverrideOpInventory struct {
+	InventoryMode InventoryMode `json:"inventory_mode,string"`
+}
+
+// LLDOverrideOperation represents an action applied to the prototypes matching the override
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/discoveryrule/object#lld-rule-override-operation
+type LLDOverrideOperation struct {
+	OperationObject LLDOverrideObjectType   `json:"operationobject"`
+	Operator        LLDOverrideOperatorType `json:"operator,omitempty"`
+	Value           string                  `json:"value,omitempty"`
+
+	OpStatus    *LLDOverrideOpStatus    `json:"opstatus,omitempty"`
+	OpDiscover  *LLDOverrideOpDiscover  `json:"opdiscover,omitempty"`
+	OpPeriod    *LLDOverrideOpPeriod    `json:"opperiod,omitempty"`
+	OpHistory   *LLDOverrideOpHistory   `json:"ophistory,omitempty"`
+	OpTrends    *LLDOverrideOpTrends    `json:"optrends,omitempty"`
+	OpSeverity  *LLDOverrideOpSeverity  `json:"opseverity,omitempty"`
+	OpTags      Tags                    `json:"optag,omitempty"`
+	OpTemplates TemplateIDs             `json:"optemplate,omitempty"`
+	OpInventory *LLDOverrideOpInventory `json:"opinventory,omitempty"`
+}
+
+type LLDOverrideOperations []LLDOverrideOperation
+
+// LLDOverride represents an override of the LLD rule, available since 5.0
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/discoveryrule/object#lld-rule-overrides
+type LLDOverride struct {
+	Name       string                `json:"name"`
+	Step       int                   `json:"step,string"`
+	Stop       LLDOverrideStopType   `json:"stop,omitempty"`
+	Filter     *LLDRuleFilter        `json:"filter,omitempty"`
+	Operations LLDOverrideOperations `json:"operations,omitempty"`
+}
+
+type LLDOverrides []LLDOverride
+
 type LLDMacroPath struct {
 	Macro string `json:"lld_macro"`
 	Path  string `json:"path"`
@@ -100,6 +195,7 @@ type LLDRule struct {
 	Preprocessors Preprocessors `json:"preprocessing,omitempty"`
 	Filter        LLDRuleFilter `json:"filter"`
 	MacroPaths    LLDMacroPaths `json:"lld_macro_paths,omitempty"`
+	Overrides     LLDOverrides  `json:"overrides,omitempty"`
 }
 
 // Items is an array of Item
@@ -130,16 +226,32 @@ func (api *API) lldsHeadersUnmarshal(item LLDRules) {
 	}
 }
 
-func prepLLDs(item LLDRules) {
-	for i := 0; i < len(item); i++ {
-		h := item[i]
+// prepLLDs returns copies of the rules without read only fields, the rules are left untouched.
+func prepLLDs(items LLDRules) LLDRules {
+	res := make(LLDRules, len(items))
+	for i, h := range items {
+		// eval_formula is read back only, it is rejected on update
+		h.Filter.EvalFormula = ""
+		if h.Overrides != nil {
+			overrides := make(LLDOverrides, len(h.Overrides))
+			for j, o := range h.Overrides {
+				if o.Filter != nil {
+					filter := *o.Filter
+					filter.EvalFormula = ""
+					o.Filter = &filter
+				}
+				overrides[j] = o
+			}
+			h.Overrides = overrides
+		}
 
-		if h.Headers == nil {
-			continue
+		if h.Headers != nil {
+			asB, _ := json.Marshal(h.Headers)
+			h.RawHeaders = json.RawMessage(asB)
 		}
-		asB, _ := json.Marshal(h.Headers)
-		item[i].RawHeaders = json.RawMessage(asB)
+		res[i] = h
 	}
+	return res
 }
 
 // ItemsGet Wrapper for item.get
@@ -155,7 +267,12 @@ func (api *API) LLDsGet(params Params) (res LLDRules, err error) {
 
 // ItemGetByID Gets item by Id only if there is exactly 1 matching host.
 func (api *API) LLDGetByID(id string) (res *LLDRule, err error) {
-	items, err := api.LLDsGet(Params{"itemids": id})
+	items, err := api.LLDsGet(Params{
+		"itemids":             id,
+		"selectFilter":        "extend",
+		"selectLLDMacroPaths": "extend",
+		"selectOverrides":     "extend",
+	})
 	if err != nil {
 		return
 	}
@@ -172,8 +289,7 @@ func (api *API) LLDGetByID(id string) (res *LLDRule, err error) {
 // ItemsCreate Wrapper for item.create
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/item/create
 func (api *API) LLDsCreate(items LLDRules) (err error) {
-	prepLLDs(items)
-	response, err := api.CallWithError("discoveryrule.create", items)
+	response, err := api.CallWithError("discoveryrule.create", prepLLDs(items))
 	if err != nil {
 		return
 	}
@@ -189,8 +305,7 @@ func (api *API) LLDsCreate(items LLDRules) (err error) {
 // ItemsUpdate Wrapper for item.update
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/item/update
 func (api *API) LLDsUpdate(items LLDRules) (err error) {
-	prepLLDs(items)
-	_, err = api.CallWithError("discoveryrule.update", items)
+	_, err = api.CallWithError("discoveryrule.update", prepLLDs(items))
 	return
 }
 
@@ -0,0 +1,156 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+const lldOverridesJSON = `[{
+	"name": "Discover database host",
+	"step": "1",
+	"stop": "0",
+	"filter": {
+		"evaltype": "2",
+		"formula": "",
+		"conditions": [
+			{"macro": "{#UNIT.NAME}", "operator": "8", "value": "^mariadb\\.service$", "formulaid": "A"},
+			{"macro": "{#UNIT.NAME}", "operator": "13", "value": "", "formulaid": "B"}
+		],
+		"eval_formula": "A or B"
+	},
+	"operations": [
+		{
+			"operationobject": "3",
+			"operator": "2",
+			"value": "mariadb",
+			"opstatus": {"status": "0"},
+			"optemplate": [{"templateid": "10170"}],
+			"optag": [{"tag": "Database", "value": "MySQL"}],
+			"opinventory": {"inventory_mode": "0"}
+		},
+		{
+			"operationobject": "1",
+			"operator": "0",
+			"value": "High load",
+			"opseverity": {"severity": "4"},
+			"opdiscover": {"discover": "1"}
+		}
+	]
+}]`
+
+func TestLLDOverridesJSON(t *testing.T) {
+	var overrides zapi.LLDOverrides
+	if err := json.Unmarshal([]byte(lldOverridesJSON), &overrides); err != nil {
+		t.Fatal(err)
+	}
+	if len(overrides) != 1 || len(overrides[0].Operations) != 2 {
+		t.Fatalf("Bad overrides: %#v", overrides)
+	}
+	if overrides[0].Filter.Conditions[1].Operator != zapi.LLDNotExists {
+		t.Errorf("Bad condition: %#v", overrides[0].Filter.Conditions[1])
+	}
+	op := overrides[0].Operations[0]
+	if op.OperationObject != zapi.LLDOverrideHostPrototype || op.OpTemplates[0].TemplateID != "10170" || op.OpInventory.InventoryMode != zapi.InventoryManual {
+		t.Errorf("Bad operation: %#v", op)
+	}
+	if overrides[0].Operations[1].OpSeverity.Severity != zapi.High {
+		t.Errorf("Bad operation: %#v", overrides[0].Operations[1])
+	}
+
+	b, err := json.Marshal(overrides)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var overrides2 zapi.LLDOverrides
+	if err := json.Unmarshal(b, &overrides2); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(overrides, overrides2) {
+		t.Errorf("Overrides are not equal:\n%#v\n%#v", overrides, overrides2)
+	}
+}
+
+func CreateLLDRule(host *zapi.Host, t *testing.T) *zapi.LLDRule {
+	rules := zapi.LLDRules{{
+		HostID: host.HostID,
+		Key:    "lld.lala.laa",
+		Name:   "name for lld",
+		Type:   zapi.ZabbixTrapper,
+		Filter: zapi.LLDRuleFilter{
+			EvalType: zapi.LLDAndOr,
+			Conditions: zapi.LLDRuleFilterConditions{
+				{Macro: "{#NAME}", Operator: zapi.LLDExists},
+			},
+		},
+		Overrides: zapi.LLDOverrides{{
+			Name: "skip tmp",
+			Step: 1,
+			Stop: zapi.LLDOverrideStop,
+			Filter: &zapi.LLDRuleFilter{
+				EvalType: zapi.LLDAndOr,
+				Conditions: zapi.LLDRuleFilterConditions{
+					{Macro: "{#NAME}", Value: "^tmp", Operator: zapi.LLDMatch},
+				},
+			},
+			Operations: zapi.LLDOverrideOperations{{
+				OperationObject: zapi.LLDOverrideItemPrototype,
+				Operator:        zapi.LLDOverrideContains,
+				Value:           "tmp",
+				OpDiscover:      &zapi.LLDOverrideOpDiscover{Discover: "1"},
+				OpTags:          zapi.Tags{{Tag: "scope", Value: "tmp"}},
+			}},
+		}},
+	}}
+	err := getAPI(t).LLDsCreate(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &rules[0]
+}
+
+func DeleteLLDRule(rule *zapi.LLDRule, t *testing.T) {
+	err := getAPI(t).LLDsDelete(zapi.LLDRules{*rule})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLLDOverrides(t *testing.T) {
+	api := getAPI(t)
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	host := CreateHost(group, t)
+	defer DeleteHost(host, t)
+
+	rule := CreateLLDRule(host, t)
+	defer DeleteLLDRule(rule, t)
+
+	rule2, err := api.LLDGetByID(rule.ItemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rule2.Overrides) != 1 || len(rule2.Overrides[0].Operations) != 1 {
+		t.Fatalf("Bad overrides: %#v", rule2.Overrides)
+	}
+
+	err = api.LLDsUpdate(zapi.LLDRules{*rule2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule2.Overrides[0].Filter.EvalFormula == "" {
+		t.Errorf("Update changed the rule: %#v", rule2.Overrides[0].Filter)
+	}
+
+	rule3, err := api.LLDGetByID(rule.ItemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(rule2.Overrides, rule3.Overrides) {
+		t.Errorf("Overrides are not equal:\n%#v\n%#v", rule2.Overrides, rule3.Overrides)
+	}
+}
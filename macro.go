@@ -1,19 +1,119 @@
 package zabbix
 
+import (
+	"encoding/json"
+	"sort"
+)
+
+type (
+	// MacroType type of the macro value
+	// see "type" in https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/object
+	MacroType int
+
+	// MacroScope level a macro is defined on
+	MacroScope int
+)
+
+const (
+	// MacroText text macro (default)
+	MacroText MacroType = 0
+	// MacroSecret secret text macro, value is not returned by the API
+	MacroSecret MacroType = 1
+	// MacroVault vault secret macro, value is a path to the secret
+	MacroVault MacroType = 2
+)
+
+const (
+	// MacroScopeHost macro defined on the host
+	MacroScopeHost MacroScope = iota
+	// MacroScopeTemplate macro defined on a template linked to the host
+	MacroScopeTemplate
+	// MacroScopeGlobal global macro
+	MacroScopeGlobal
+)
+
 // Macro represent Zabbix User MAcro object
-// https://www.zabbix.com/documentation/3.2/manual/api/reference/usermacro/object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/object
 type Macro struct {
-	MacroID   string `json:"hostmacroids,omitempty"`
-	HostID    string `json:"hostid,omitempty"`
-	MacroName string `json:"macro"`
-	Value     string `json:"value"`
+	MacroID       string    `json:"hostmacroid,omitempty"`
+	GlobalMacroID string    `json:"globalmacroid,omitempty"`
+	HostID        string    `json:"hostid,omitempty"`
+	MacroName     string    `json:"macro"`
+	Value         string    `json:"value"`
+	Type          MacroType `json:"type,string"`
+	Description   string    `json:"description,omitempty"`
+
+	// untyped is set for servers before 5.0, which reject the type
+	untyped bool
+}
+
+type macro Macro
+
+// MarshalJSON leaves out an empty value of a secret macro, the API never returns it
+// and sending it back empty would wipe the secret, and the type when untyped.
+func (m Macro) MarshalJSON() ([]byte, error) {
+	var value *string
+	if m.Value != "" || m.Type != MacroSecret {
+		value = &m.Value
+	}
+	var typ *MacroType
+	if !m.untyped {
+		typ = &m.Type
+	}
+	return json.Marshal(struct {
+		macro
+		Value *string    `json:"value,omitempty"`
+		Type  *MacroType `json:"type,string,omitempty"`
+	}{macro(m), value, typ})
 }
 
 // Macros is an array of Macro
 type Macros []Macro
 
+// prepMacros returns copies of the macros with fields of the server version
+func (api *API) prepMacros(macros Macros) Macros {
+	if macros == nil {
+		return nil
+	}
+	res := make(Macros, len(macros))
+	for i, m := range macros {
+		m.untyped = api.Config.Version < 50000
+		res[i] = m
+	}
+	return res
+}
+
+// names returns names of the macros, as taken by massremove methods
+func (macros Macros) names() []string {
+	res := make([]string, len(macros))
+	for i, macro := range macros {
+		res[i] = macro.MacroName
+	}
+	return res
+}
+
+// MacroChainLink is a definition of a macro on one level
+type MacroChainLink struct {
+	Scope MacroScope
+	Macro Macro
+}
+
+// MacroChain is every definition of a macro visible to a host, highest precedence first
+type MacroChain []MacroChainLink
+
+// Effective returns the definition Zabbix resolves the macro to, nil for empty chain.
+func (c MacroChain) Effective() *Macro {
+	if len(c) == 0 {
+		return nil
+	}
+	return &c[0].Macro
+}
+
+// MacroChains maps macro name to its chain
+type MacroChains map[string]MacroChain
+
 // MacrosGet Wrapper for usermacro.get
-// https://www.zabbix.com/documentation/3.2/manual/api/reference/usermacro/get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/get
 func (api *API) MacrosGet(params Params) (res Macros, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
@@ -39,9 +139,9 @@ func (api *API) MacroGetByID(id string) (res *Macro, err error) {
 }
 
 // MacrosCreate Wrapper for usermacro.create
-// https://www.zabbix.com/documentation/3.2/manual/api/reference/usermacro/create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/create
 func (api *API) MacrosCreate(macros Macros) error {
-	response, err := api.CallWithError("usermacro.create", macros)
+	response, err := api.CallWithError("usermacro.create", api.prepMacros(macros))
 	if err != nil {
 		return err
 	}
@@ -49,23 +149,31 @@ func (api *API) MacrosCreate(macros Macros) error {
 	result := response.Result.(map[string]interface{})
 	macroids := result["hostmacroids"].([]interface{})
 	for i, id := range macroids {
-		macros[i].HostID = id.(string)
+		macros[i].MacroID = id.(string)
 	}
 	return nil
 }
 
 // MacrosUpdate Wrapper for usermacro.update
-// https://www.zabbix.com/documentation/3.2/manual/api/reference/usermacro/update
+// The host of a macro can't be changed, HostID is not sent.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/update
 func (api *API) MacrosUpdate(macros Macros) (err error) {
-	_, err = api.CallWithError("usermacro.create", macros)
+	update := api.prepMacros(macros)
+	for i := range update {
+		update[i].HostID = ""
+	}
+	_, err = api.CallWithError("usermacro.update", update)
 	return
 }
 
 // MacrosDeleteByIDs Wrapper for usermacro.delete
 // Cleans MacroId in all macro elements if call succeed.
-// https://www.zabbix.com/documentation/3.2/manual/api/reference/usermacro/delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/delete
 func (api *API) MacrosDeleteByIDs(ids []string) (err error) {
 	response, err := api.CallWithError("usermacro.delete", ids)
+	if err != nil {
+		return
+	}
 
 	result := response.Result.(map[string]interface{})
 	hostmacroids := result["hostmacroids"].([]interface{})
@@ -76,7 +184,7 @@ func (api *API) MacrosDeleteByIDs(ids []string) (err error) {
 }
 
 // MacrosDelete Wrapper for usermacro.delete
-// https://www.zabbix.com/documentation/3.2/manual/api/reference/usermacro/delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/delete
 func (api *API) MacrosDelete(macros Macros) (err error) {
 	ids := make([]string, len(macros))
 	for i, macro := range macros {
@@ -91,3 +199,150 @@ func (api *API) MacrosDelete(macros Macros) (err error) {
 	}
 	return
 }
+
+// GlobalMacrosGet Wrapper for usermacro.get returning global macros only
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/get
+func (api *API) GlobalMacrosGet(params Params) (res Macros, err error) {
+	params["globalmacro"] = true
+	return api.MacrosGet(params)
+}
+
+// GlobalMacroGetByID Get global macro by ID if there is exactly 1 matching macro
+func (api *API) GlobalMacroGetByID(id string) (res *Macro, err error) {
+	macros, err := api.GlobalMacrosGet(Params{"globalmacroids": id})
+	if err != nil {
+		return
+	}
+
+	if len(macros) == 1 {
+		res = &macros[0]
+	} else {
+		e := ExpectedOneResult(len(macros))
+		err = &e
+	}
+	return
+}
+
+// GlobalMacrosCreate Wrapper for usermacro.createglobal
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/createglobal
+func (api *API) GlobalMacrosCreate(macros Macros) (err error) {
+	response, err := api.CallWithError("usermacro.createglobal", api.prepMacros(macros))
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	macroids := result["globalmacroids"].([]interface{})
+	for i, id := range macroids {
+		macros[i].GlobalMacroID = id.(string)
+	}
+	return
+}
+
+// GlobalMacrosUpdate Wrapper for usermacro.updateglobal
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/updateglobal
+func (api *API) GlobalMacrosUpdate(macros Macros) (err error) {
+	_, err = api.CallWithError("usermacro.updateglobal", api.prepMacros(macros))
+	return
+}
+
+// GlobalMacrosDeleteByIDs Wrapper for usermacro.deleteglobal
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/deleteglobal
+func (api *API) GlobalMacrosDeleteByIDs(ids []string) (err error) {
+	response, err := api.CallWithError("usermacro.deleteglobal", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	globalmacroids := result["globalmacroids"].([]interface{})
+	if len(ids) != len(globalmacroids) {
+		err = &ExpectedMore{len(ids), len(globalmacroids)}
+	}
+	return
+}
+
+// GlobalMacrosDelete Wrapper for usermacro.deleteglobal
+// Cleans GlobalMacroID in all macro elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/usermacro/deleteglobal
+func (api *API) GlobalMacrosDelete(macros Macros) (err error) {
+	ids := make([]string, len(macros))
+	for i, macro := range macros {
+		ids[i] = macro.GlobalMacroID
+	}
+
+	err = api.GlobalMacrosDeleteByIDs(ids)
+	if err == nil {
+		for i := range macros {
+			macros[i].GlobalMacroID = ""
+		}
+	}
+	return
+}
+
+// MacrosGetEffective Gets host, template and global macros visible to the host.
+// Templates are resolved the way Zabbix does: directly linked templates first,
+// then their parents level by level, lower template ID first within a level.
+func (api *API) MacrosGetEffective(hostID string) (res MacroChains, err error) {
+	// host first, then templates in resolution order
+	order := []string{hostID}
+	seen := map[string]bool{hostID: true}
+	level := []string{hostID}
+	for len(level) != 0 {
+		var templates Templates
+		templates, err = api.TemplatesGet(Params{"hostids": level, "output": []string{"templateid"}})
+		if err != nil {
+			return
+		}
+		sort.Slice(templates, func(i, j int) bool {
+			return idLess(templates[i].TemplateID, templates[j].TemplateID)
+		})
+
+		level = nil
+		for _, t := range templates {
+			if seen[t.TemplateID] {
+				continue
+			}
+			seen[t.TemplateID] = true
+			order = append(order, t.TemplateID)
+			level = append(level, t.TemplateID)
+		}
+	}
+
+	macros, err := api.MacrosGet(Params{"hostids": order})
+	if err != nil {
+		return
+	}
+	byHost := make(map[string]Macros, len(order))
+	for _, m := range macros {
+		byHost[m.HostID] = append(byHost[m.HostID], m)
+	}
+
+	res = MacroChains{}
+	for i, id := range order {
+		scope := MacroScopeTemplate
+		if i == 0 {
+			scope = MacroScopeHost
+		}
+		for _, m := range byHost[id] {
+			res[m.MacroName] = append(res[m.MacroName], MacroChainLink{scope, m})
+		}
+	}
+
+	globals, err := api.GlobalMacrosGet(Params{})
+	if err != nil {
+		return
+	}
+	for _, m := range globals {
+		res[m.MacroName] = append(res[m.MacroName], MacroChainLink{MacroScopeGlobal, m})
+	}
+	return
+}
+
+// idLess compares numeric Zabbix IDs.
+func idLess(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
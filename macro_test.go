@@ -0,0 +1,193 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestMacros(t *testing.T) {
+	api := getAPI(t)
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	host := CreateHost(group, t)
+	defer DeleteHost(host, t)
+
+	macros := zapi.Macros{{HostID: host.HostID, MacroName: "{$PASSWORD}", Value: "secret", Type: zapi.MacroSecret}}
+	err := api.MacrosCreate(macros)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if macros[0].MacroID == "" {
+		t.Fatalf("Id is empty: %#v", macros[0])
+	}
+
+	macro, err := api.MacroGetByID(macros[0].MacroID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if macro.MacroID != macros[0].MacroID || macro.HostID != host.HostID || macro.Type != zapi.MacroSecret || macro.Value != "" {
+		t.Errorf("Bad macro: %#v", macro)
+	}
+
+	// the secret is not read back, updating the macro as read must keep it
+	macro.Description = "changed"
+	err = api.MacrosUpdate(zapi.Macros{*macro})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if macro.HostID != host.HostID {
+		t.Errorf("Macro is changed: %#v", macro)
+	}
+	macro, err = api.MacroGetByID(macros[0].MacroID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if macro.Description != "changed" || macro.Type != zapi.MacroSecret {
+		t.Errorf("Macro is not updated: %#v", macro)
+	}
+
+	// switching back to text must send type "0"
+	macro.Type = zapi.MacroText
+	macro.Value = "plain"
+	err = api.MacrosUpdate(zapi.Macros{*macro})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := api.MacrosGet(zapi.Params{"hostids": host.HostID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || res[0].MacroID != macros[0].MacroID || res[0].Type != zapi.MacroText || res[0].Value != "plain" {
+		t.Errorf("Macro is not updated in place: %#v", res)
+	}
+
+	err = api.MacrosDelete(macros)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if macros[0].MacroID != "" {
+		t.Errorf("Id is not cleaned: %#v", macros[0])
+	}
+}
+
+func TestMacroSecretValue(t *testing.T) {
+	b, err := json.Marshal(zapi.Macro{MacroName: "{$PASSWORD}", Type: zapi.MacroSecret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), `"value"`) || !strings.Contains(string(b), `"type":"1"`) {
+		t.Errorf("Bad secret macro: %s", b)
+	}
+
+	b, err = json.Marshal(zapi.Macro{MacroName: "{$EMPTY}"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"value":""`) || !strings.Contains(string(b), `"type":"0"`) {
+		t.Errorf("Bad text macro: %s", b)
+	}
+}
+
+func TestGlobalMacros(t *testing.T) {
+	api := getAPI(t)
+
+	macros := zapi.Macros{{MacroName: fmt.Sprintf("{$ZABBIX_TESTING_%d}", rand.Int()), Value: "global"}}
+	err := api.GlobalMacrosCreate(macros)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if macros[0].GlobalMacroID == "" {
+		t.Fatalf("Id is empty: %#v", macros[0])
+	}
+
+	macro, err := api.GlobalMacroGetByID(macros[0].GlobalMacroID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if macro.MacroName != macros[0].MacroName || macro.Value != "global" {
+		t.Errorf("Bad macro: %#v", macro)
+	}
+
+	macro.Value = "changed"
+	err = api.GlobalMacrosUpdate(zapi.Macros{*macro})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := api.GlobalMacrosGet(zapi.Params{"globalmacroids": macros[0].GlobalMacroID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || res[0].Value != "changed" {
+		t.Errorf("Macro is not updated: %#v", res)
+	}
+
+	err = api.GlobalMacrosDelete(macros)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if macros[0].GlobalMacroID != "" {
+		t.Errorf("Id is not cleaned: %#v", macros[0])
+	}
+}
+
+func TestMacrosGetEffective(t *testing.T) {
+	api := getAPI(t)
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	var templateGroupID string
+	if api.Config.Version >= 60200 {
+		templateGroup := CreateTemplateGroup(t)
+		defer DeleteTemplateGroup(templateGroup, t)
+		templateGroupID = templateGroup.GroupID
+	} else {
+		templateGroupID = group.GroupID
+	}
+	template := CreateTemplate(templateGroupID, t)
+	defer DeleteTemplate(template, t)
+
+	host := CreateHost(group, t)
+	defer DeleteHost(host, t)
+
+	err := api.HostsMassAdd(zapi.Hosts{*host}, zapi.HostMassOptions{Templates: zapi.TemplateIDs{{template.TemplateID}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = api.MacrosCreate(zapi.Macros{
+		{HostID: template.TemplateID, MacroName: "{$SHARED}", Value: "template"},
+		{HostID: template.TemplateID, MacroName: "{$TEMPLATE_ONLY}", Value: "template"},
+		{HostID: host.HostID, MacroName: "{$SHARED}", Value: "host"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	chains, err := api.MacrosGetEffective(host.HostID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	shared := chains["{$SHARED}"]
+	if len(shared) < 2 || shared[0].Scope != zapi.MacroScopeHost || shared[1].Scope != zapi.MacroScopeTemplate {
+		t.Fatalf("Bad chain: %#v", shared)
+	}
+	if shared.Effective().Value != "host" {
+		t.Errorf("Bad effective macro: %#v", shared.Effective())
+	}
+
+	templateOnly := chains["{$TEMPLATE_ONLY}"]
+	if templateOnly.Effective() == nil || templateOnly.Effective().Value != "template" || templateOnly[0].Scope != zapi.MacroScopeTemplate {
+		t.Errorf("Bad chain: %#v", templateOnly)
+	}
+}
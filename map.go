@@ -0,0 +1,323 @@
+package zabbix
+
+import (
+	"math"
+	"strconv"
+)
+
+type (
+	// MapElementType type of the map element
+	// see "elementtype" in https://www.zabbix.com/documentation/current/en/manual/api/reference/map/object#map-element
+	MapElementType int
+
+	// MapLinkDrawType line style of a map link
+	MapLinkDrawType int
+
+	// MapPermission access level to a shared map
+	MapPermission int
+
+	// MapLayout automatic placement of generated map elements
+	MapLayout int
+)
+
+const (
+	MapElementHost      MapElementType = 0
+	MapElementMap       MapElementType = 1
+	MapElementTrigger   MapElementType = 2
+	MapElementHostGroup MapElementType = 3
+	MapElementImage     MapElementType = 4
+)
+
+const (
+	MapLinkLine   MapLinkDrawType = 0
+	MapLinkBold   MapLinkDrawType = 2
+	MapLinkDot    MapLinkDrawType = 3
+	MapLinkDashed MapLinkDrawType = 4
+)
+
+const (
+	// MapReadOnly read-only access
+	MapReadOnly MapPermission = 2
+	// MapReadWrite read-write access
+	MapReadWrite MapPermission = 3
+)
+
+const (
+	// MapLayoutGrid places elements row by row on a square grid
+	MapLayoutGrid MapLayout = iota
+	// MapLayoutCircular places elements on a circle
+	MapLayoutCircular
+)
+
+// MapElementRef references the object displayed by a map element,
+// only the ID matching the element type is set.
+type MapElementRef struct {
+	HostID    string `json:"hostid,omitempty"`
+	GroupID   string `json:"groupid,omitempty"`
+	TriggerID string `json:"triggerid,omitempty"`
+	SysMapID  string `json:"sysmapid,omitempty"`
+}
+
+// MapElementRefs is an array of MapElementRef
+type MapElementRefs []MapElementRef
+
+// MapURL represent Zabbix map URL object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/map/object#map-url
+type MapURL struct {
+	SysMapURLID string         `json:"sysmapurlid,omitempty"`
+	Name        string         `json:"name"`
+	Url         string         `json:"url"`
+	ElementType MapElementType `json:"elementtype,string"`
+}
+
+// MapURLs is an array of MapURL
+type MapURLs []MapURL
+
+// MapElementURL represents URL of a single map element
+type MapElementURL struct {
+	Name string `json:"name"`
+	Url  string `json:"url"`
+}
+
+// MapElement represent Zabbix map element object.
+// SelementID may be set to a temporary value on create to reference the element from links.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/map/object#map-element
+type MapElement struct {
+	SelementID        string          `json:"selementid,omitempty"`
+	ElementType       MapElementType  `json:"elementtype,string"`
+	Elements          MapElementRefs  `json:"elements,omitempty"`
+	IconIDOff         string          `json:"iconid_off"`
+	IconIDOn          string          `json:"iconid_on,omitempty"`
+	IconIDDisabled    string          `json:"iconid_disabled,omitempty"`
+	IconIDMaintenance string          `json:"iconid_maintenance,omitempty"`
+	UseIconMap        string          `json:"use_iconmap,omitempty"`
+	Label             string          `json:"label,omitempty"`
+	LabelLocation     string          `json:"label_location,omitempty"`
+	X                 int             `json:"x,string"`
+	Y                 int             `json:"y,string"`
+	Width             string          `json:"width,omitempty"`
+	Height            string          `json:"height,omitempty"`
+	URLs              []MapElementURL `json:"urls,omitempty"`
+	Tags              Tags            `json:"tags,omitempty"`
+}
+
+// MapElements is an array of MapElement
+type MapElements []MapElement
+
+// MapLinkTrigger colors the link while the trigger is in problem state
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/map/object#map-link-trigger
+type MapLinkTrigger struct {
+	LinkTriggerID string          `json:"linktriggerid,omitempty"`
+	TriggerID     string          `json:"triggerid"`
+	DrawType      MapLinkDrawType `json:"drawtype,string"`
+	Color         string          `json:"color,omitempty"`
+}
+
+// MapLinkTriggers is an array of MapLinkTrigger
+type MapLinkTriggers []MapLinkTrigger
+
+// MapLink represent Zabbix map link object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/map/object#map-link
+type MapLink struct {
+	LinkID       string          `json:"linkid,omitempty"`
+	SelementID1  string          `json:"selementid1"`
+	SelementID2  string          `json:"selementid2"`
+	DrawType     MapLinkDrawType `json:"drawtype,string"`
+	Color        string          `json:"color,omitempty"`
+	Label        string          `json:"label,omitempty"`
+	LinkTriggers MapLinkTriggers `json:"linktriggers,omitempty"`
+}
+
+// MapLinks is an array of MapLink
+type MapLinks []MapLink
+
+// MapUser shares the map with a user
+type MapUser struct {
+	SysMapUserID string        `json:"sysmapuserid,omitempty"`
+	UserID       string        `json:"userid"`
+	Permission   MapPermission `json:"permission,string"`
+}
+
+// MapUserGroup shares the map with a user group
+type MapUserGroup struct {
+	SysMapUserGroupID string        `json:"sysmapusrgrpid,omitempty"`
+	UserGroupID       string        `json:"usrgrpid"`
+	Permission        MapPermission `json:"permission,string"`
+}
+
+// Map represent Zabbix network map object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/map/object
+type Map struct {
+	SysMapID      string  `json:"sysmapid,omitempty"`
+	Name          string  `json:"name"`
+	Width         int     `json:"width,string"`
+	Height        int     `json:"height,string"`
+	BackgroundID  string  `json:"backgroundid,omitempty"`
+	IconMapID     string  `json:"iconmapid,omitempty"`
+	UserID        string  `json:"userid,omitempty"`
+	Private       Sharing `json:"private,omitempty"`
+	ExpandMacros  string  `json:"expand_macros,omitempty"`
+	ExpandProblem string  `json:"expandproblem,omitempty"`
+	GridAlign     string  `json:"grid_align,omitempty"`
+	GridShow      string  `json:"grid_show,omitempty"`
+	GridSize      string  `json:"grid_size,omitempty"`
+	Highlight     string  `json:"highlight,omitempty"`
+	LabelType     string  `json:"label_type,omitempty"`
+	LabelLocation string  `json:"label_location,omitempty"`
+	MarkElements  string  `json:"markelements,omitempty"`
+	SeverityMin   string  `json:"severity_min,omitempty"`
+	ShowUnack     string  `json:"show_unack,omitempty"`
+
+	Elements   MapElements    `json:"selements,omitempty"`
+	Links      MapLinks       `json:"links,omitempty"`
+	URLs       MapURLs        `json:"urls,omitempty"`
+	Users      []MapUser      `json:"users,omitempty"`
+	UserGroups []MapUserGroup `json:"userGroups,omitempty"`
+}
+
+// Maps is an array of Map
+type Maps []Map
+
+const (
+	mapMargin  = 50
+	mapSpacing = 150
+)
+
+// MapFromHostGroup Builds a map showing hosts of the host group with the given icon,
+// placed by the layout. The map is not created, pass it to MapsCreate.
+func (api *API) MapFromHostGroup(group HostGroup, name string, iconID string, layout MapLayout) (res *Map, err error) {
+	hosts, err := api.HostsGet(Params{"groupids": group.GroupID, "output": []string{"hostid", "name"}})
+	if err != nil {
+		return
+	}
+
+	res = &Map{Name: name, Elements: make(MapElements, len(hosts))}
+	n := len(hosts)
+	switch layout {
+	case MapLayoutCircular:
+		radius := float64(n*mapSpacing) / (2 * math.Pi)
+		if radius < mapSpacing {
+			radius = mapSpacing
+		}
+		center := mapMargin + int(radius)
+		for i := range hosts {
+			angle := 2 * math.Pi * float64(i) / float64(n)
+			res.Elements[i].X = center + int(radius*math.Cos(angle))
+			res.Elements[i].Y = center + int(radius*math.Sin(angle))
+		}
+		res.Width = 2 * center
+		res.Height = 2 * center
+	default:
+		columns := int(math.Ceil(math.Sqrt(float64(n))))
+		if columns == 0 {
+			columns = 1
+		}
+		rows := (n + columns - 1) / columns
+		for i := range hosts {
+			res.Elements[i].X = mapMargin + (i%columns)*mapSpacing
+			res.Elements[i].Y = mapMargin + (i/columns)*mapSpacing
+		}
+		res.Width = 2*mapMargin + columns*mapSpacing
+		res.Height = 2*mapMargin + rows*mapSpacing
+	}
+
+	for i, host := range hosts {
+		e := &res.Elements[i]
+		e.SelementID = strconv.Itoa(i + 1)
+		e.ElementType = MapElementHost
+		e.Elements = MapElementRefs{{HostID: host.HostID}}
+		e.IconIDOff = iconID
+		e.Label = host.Name
+	}
+	return
+}
+
+// MapsGet Wrapper for map.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/map/get
+func (api *API) MapsGet(params Params) (res Maps, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("map.get", params, &res)
+	return
+}
+
+// MapGetByID Gets map with elements, links, URLs and sharing by Id only if there is exactly 1 matching map.
+func (api *API) MapGetByID(id string) (res *Map, err error) {
+	maps, err := api.MapsGet(Params{
+		"sysmapids":        id,
+		"selectSelements":  "extend",
+		"selectLinks":      "extend",
+		"selectUrls":       "extend",
+		"selectUsers":      "extend",
+		"selectUserGroups": "extend",
+	})
+	if err != nil {
+		return
+	}
+
+	if len(maps) == 1 {
+		res = &maps[0]
+	} else {
+		e := ExpectedOneResult(len(maps))
+		err = &e
+	}
+	return
+}
+
+// MapsCreate Wrapper for map.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/map/create
+func (api *API) MapsCreate(maps Maps) (err error) {
+	response, err := api.CallWithError("map.create", maps)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	sysmapids := result["sysmapids"].([]interface{})
+	for i, id := range sysmapids {
+		maps[i].SysMapID = id.(string)
+	}
+	return
+}
+
+// MapsUpdate Wrapper for map.update
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/map/update
+func (api *API) MapsUpdate(maps Maps) (err error) {
+	_, err = api.CallWithError("map.update", maps)
+	return
+}
+
+// MapsDelete Wrapper for map.delete
+// Cleans SysMapID in all maps elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/map/delete
+func (api *API) MapsDelete(maps Maps) (err error) {
+	ids := make([]string, len(maps))
+	for i, m := range maps {
+		ids[i] = m.SysMapID
+	}
+
+	err = api.MapsDeleteByIds(ids)
+	if err == nil {
+		for i := range maps {
+			maps[i].SysMapID = ""
+		}
+	}
+	return
+}
+
+// MapsDeleteByIds Wrapper for map.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/map/delete
+func (api *API) MapsDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("map.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	sysmapids := result["sysmapids"].([]interface{})
+	if len(ids) != len(sysmapids) {
+		err = &ExpectedMore{len(ids), len(sysmapids)}
+	}
+	return
+}
@@ -0,0 +1,125 @@
+package zabbix_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestMapFromHostGroup(t *testing.T) {
+	api := getAPI(t)
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	hostIDs := map[string]bool{}
+	for i := 0; i < 3; i++ {
+		host := CreateHost(group, t)
+		defer DeleteHost(host, t)
+		hostIDs[host.HostID] = true
+	}
+
+	m, err := api.MapFromHostGroup(*group, "grid", "1", zapi.MapLayoutGrid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Elements) != 3 {
+		t.Fatalf("Expected 3 elements, got %#v", m.Elements)
+	}
+	// 3 hosts fill a 2x2 grid
+	if m.Width != 400 || m.Height != 400 {
+		t.Errorf("Bad size: %dx%d", m.Width, m.Height)
+	}
+	if e := m.Elements[2]; e.X != 50 || e.Y != 200 {
+		t.Errorf("Bad element position: %#v", e)
+	}
+	for _, e := range m.Elements {
+		if e.ElementType != zapi.MapElementHost || len(e.Elements) != 1 || !hostIDs[e.Elements[0].HostID] || e.IconIDOff != "1" {
+			t.Errorf("Bad element: %#v", e)
+		}
+	}
+
+	m, err = api.MapFromHostGroup(*group, "circular", "1", zapi.MapLayoutCircular)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// radius is at least the element spacing
+	if m.Width != 400 || m.Height != 400 {
+		t.Errorf("Bad size: %dx%d", m.Width, m.Height)
+	}
+	if e := m.Elements[0]; e.X != 350 || e.Y != 200 {
+		t.Errorf("Bad element position: %#v", e)
+	}
+	for _, e := range m.Elements {
+		if e.X < 0 || e.Y < 0 || e.X > m.Width || e.Y > m.Height {
+			t.Errorf("Element out of the map: %#v", e)
+		}
+	}
+	if m.Private != zapi.SharingDefault {
+		t.Errorf("Sharing is set: %#v", m)
+	}
+}
+
+func TestMaps(t *testing.T) {
+	api := getAPI(t)
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	for i := 0; i < 2; i++ {
+		host := CreateHost(group, t)
+		defer DeleteHost(host, t)
+	}
+
+	m, err := api.MapFromHostGroup(*group, fmt.Sprintf("zabbix-testing-%d", rand.Int()), "1", zapi.MapLayoutGrid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Links = zapi.MapLinks{{SelementID1: m.Elements[0].SelementID, SelementID2: m.Elements[1].SelementID}}
+	maps := zapi.Maps{*m}
+	err = api.MapsCreate(maps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if maps[0].SysMapID == "" {
+		t.Fatalf("Id is empty: %#v", maps[0])
+	}
+
+	m, err = api.MapGetByID(maps[0].SysMapID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Name != maps[0].Name || len(m.Elements) != 2 || len(m.Links) != 1 || m.Width != maps[0].Width {
+		t.Errorf("Bad map: %#v", m)
+	}
+	for _, e := range m.Elements {
+		if e.ElementType != zapi.MapElementHost || len(e.Elements) != 1 || e.Elements[0].HostID == "" {
+			t.Errorf("Bad element: %#v", e)
+		}
+	}
+
+	// elements and links are kept when not given
+	update := zapi.Map{SysMapID: m.SysMapID, Name: m.Name + "-renamed", Width: m.Width, Height: m.Height, Private: zapi.SharingPublic}
+	err = api.MapsUpdate(zapi.Maps{update})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err = api.MapGetByID(maps[0].SysMapID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Name != update.Name || m.Private != zapi.SharingPublic || len(m.Elements) != 2 || len(m.Links) != 1 {
+		t.Errorf("Map is not updated: %#v", m)
+	}
+
+	err = api.MapsDelete(maps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if maps[0].SysMapID != "" {
+		t.Errorf("Id is not cleaned: %#v", maps[0])
+	}
+}
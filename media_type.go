@@ -0,0 +1,232 @@
+package zabbix
+
+type (
+	// MediaTypeType transport used by the media type
+	// see "type" in https://www.zabbix.com/documentation/current/en/manual/api/reference/mediatype/object
+	MediaTypeType int
+
+	// SMTPSecurityType SMTP connection security
+	SMTPSecurityType int
+
+	// SMTPAuthenticationType SMTP authentication method
+	SMTPAuthenticationType int
+
+	// MediaTypeContentType message format of email media types
+	MediaTypeContentType int
+
+	// EventSourceType source of the event
+	// see "source" in https://www.zabbix.com/documentation/current/en/manual/api/reference/event/object
+	EventSourceType int
+
+	// MessageRecoveryType operation mode a message template is used for
+	MessageRecoveryType int
+)
+
+const (
+	// MediaTypeEmail email media type
+	MediaTypeEmail MediaTypeType = 0
+	// MediaTypeScript script media type
+	MediaTypeScript MediaTypeType = 1
+	// MediaTypeSMS SMS media type
+	MediaTypeSMS MediaTypeType = 2
+	// MediaTypeWebhook webhook media type
+	MediaTypeWebhook MediaTypeType = 4
+)
+
+const (
+	// SMTPSecurityNone no connection security (default)
+	SMTPSecurityNone SMTPSecurityType = 0
+	// SMTPSecuritySTARTTLS STARTTLS
+	SMTPSecuritySTARTTLS SMTPSecurityType = 1
+	// SMTPSecuritySSL SSL/TLS
+	SMTPSecuritySSL SMTPSecurityType = 2
+)
+
+const (
+	// SMTPAuthenticationNone no authentication (default)
+	SMTPAuthenticationNone SMTPAuthenticationType = 0
+	// SMTPAuthenticationPassword username and password
+	SMTPAuthenticationPassword SMTPAuthenticationType = 1
+)
+
+const (
+	// MediaTypeContentPlain plain text
+	MediaTypeContentPlain MediaTypeContentType = 0
+	// MediaTypeContentHTML HTML (default)
+	MediaTypeContentHTML MediaTypeContentType = 1
+)
+
+const (
+	// EventSourceTrigger event created by a trigger
+	EventSourceTrigger EventSourceType = 0
+	// EventSourceDiscovery event created by a discovery rule
+	EventSourceDiscovery EventSourceType = 1
+	// EventSourceAutoRegistration event created by active agent autoregistration
+	EventSourceAutoRegistration EventSourceType = 2
+	// EventSourceInternal internal event
+	EventSourceInternal EventSourceType = 3
+	// EventSourceService event created on service status update
+	EventSourceService EventSourceType = 4
+)
+
+const (
+	// MessageProblem problem operations
+	MessageProblem MessageRecoveryType = 0
+	// MessageRecovery recovery operations
+	MessageRecovery MessageRecoveryType = 1
+	// MessageUpdate update operations
+	MessageUpdate MessageRecoveryType = 2
+)
+
+// MediaTypeParameter represents webhook or script parameter of a media type.
+// Webhooks use Name, scripts (since 6.4) use SortOrder.
+type MediaTypeParameter struct {
+	Name      string `json:"name,omitempty"`
+	SortOrder string `json:"sortorder,omitempty"`
+	Value     string `json:"value"`
+}
+
+// MediaTypeParameters is an array of MediaTypeParameter
+type MediaTypeParameters []MediaTypeParameter
+
+// MessageTemplate represents default message of a media type
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/mediatype/object#message-template
+type MessageTemplate struct {
+	EventSource EventSourceType     `json:"eventsource,string"`
+	Recovery    MessageRecoveryType `json:"recovery,string"`
+	Subject     string              `json:"subject,omitempty"`
+	Message     string              `json:"message,omitempty"`
+}
+
+// MessageTemplates is an array of MessageTemplate
+type MessageTemplates []MessageTemplate
+
+// MediaType represent Zabbix media type object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/mediatype/object
+type MediaType struct {
+	MediaTypeID     string        `json:"mediatypeid,omitempty"`
+	Name            string        `json:"name"`
+	Type            MediaTypeType `json:"type,string"`
+	Status          StatusType    `json:"status,string"`
+	Description     string        `json:"description,omitempty"`
+	MaxSessions     string        `json:"maxsessions,omitempty"`
+	MaxAttempts     string        `json:"maxattempts,omitempty"`
+	AttemptInterval string        `json:"attempt_interval,omitempty"`
+
+	// Email fields
+	SMTPServer         string                 `json:"smtp_server,omitempty"`
+	SMTPPort           string                 `json:"smtp_port,omitempty"`
+	SMTPHelo           string                 `json:"smtp_helo,omitempty"`
+	SMTPEmail          string                 `json:"smtp_email,omitempty"`
+	SMTPSecurity       SMTPSecurityType       `json:"smtp_security,omitempty,string"`
+	SMTPVerifyHost     string                 `json:"smtp_verify_host,omitempty"`
+	SMTPVerifyPeer     string                 `json:"smtp_verify_peer,omitempty"`
+	SMTPAuthentication SMTPAuthenticationType `json:"smtp_authentication,omitempty,string"`
+	Username           string                 `json:"username,omitempty"`
+	Password           string                 `json:"passwd,omitempty"`
+	ContentType        MediaTypeContentType   `json:"content_type,omitempty,string"`
+
+	// SMS fields
+	GSMModem string `json:"gsm_modem,omitempty"`
+
+	// Script fields
+	ExecPath   string `json:"exec_path,omitempty"`
+	ExecParams string `json:"exec_params,omitempty"`
+
+	// Webhook fields
+	Script        string              `json:"script,omitempty"`
+	Timeout       string              `json:"timeout,omitempty"`
+	ProcessTags   string              `json:"process_tags,omitempty"`
+	ShowEventMenu string              `json:"show_event_menu,omitempty"`
+	EventMenuURL  string              `json:"event_menu_url,omitempty"`
+	EventMenuName string              `json:"event_menu_name,omitempty"`
+	Parameters    MediaTypeParameters `json:"parameters,omitempty"`
+
+	MessageTemplates MessageTemplates `json:"message_templates,omitempty"`
+}
+
+// MediaTypes is an array of MediaType
+type MediaTypes []MediaType
+
+// MediaTypesGet Wrapper for mediatype.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/mediatype/get
+func (api *API) MediaTypesGet(params Params) (res MediaTypes, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("mediatype.get", params, &res)
+	return
+}
+
+// MediaTypeGetByID Gets media type by Id only if there is exactly 1 matching media type.
+func (api *API) MediaTypeGetByID(id string) (res *MediaType, err error) {
+	mediaTypes, err := api.MediaTypesGet(Params{"mediatypeids": id, "selectMessageTemplates": "extend"})
+	if err != nil {
+		return
+	}
+
+	if len(mediaTypes) == 1 {
+		res = &mediaTypes[0]
+	} else {
+		e := ExpectedOneResult(len(mediaTypes))
+		err = &e
+	}
+	return
+}
+
+// MediaTypesCreate Wrapper for mediatype.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/mediatype/create
+func (api *API) MediaTypesCreate(mediaTypes MediaTypes) (err error) {
+	response, err := api.CallWithError("mediatype.create", mediaTypes)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	mediatypeids := result["mediatypeids"].([]interface{})
+	for i, id := range mediatypeids {
+		mediaTypes[i].MediaTypeID = id.(string)
+	}
+	return
+}
+
+// MediaTypesUpdate Wrapper for mediatype.update
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/mediatype/update
+func (api *API) MediaTypesUpdate(mediaTypes MediaTypes) (err error) {
+	_, err = api.CallWithError("mediatype.update", mediaTypes)
+	return
+}
+
+// MediaTypesDelete Wrapper for mediatype.delete
+// Cleans MediaTypeID in all mediaTypes elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/mediatype/delete
+func (api *API) MediaTypesDelete(mediaTypes MediaTypes) (err error) {
+	ids := make([]string, len(mediaTypes))
+	for i, mediaType := range mediaTypes {
+		ids[i] = mediaType.MediaTypeID
+	}
+
+	err = api.MediaTypesDeleteByIds(ids)
+	if err == nil {
+		for i := range mediaTypes {
+			mediaTypes[i].MediaTypeID = ""
+		}
+	}
+	return
+}
+
+// MediaTypesDeleteByIds Wrapper for mediatype.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/mediatype/delete
+func (api *API) MediaTypesDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("mediatype.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	mediatypeids := result["mediatypeids"].([]interface{})
+	if len(ids) != len(mediatypeids) {
+		err = &ExpectedMore{len(ids), len(mediatypeids)}
+	}
+	return
+}
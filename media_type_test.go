@@ -0,0 +1,85 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func CreateMediaType(t *testing.T) *zapi.MediaType {
+	mediaTypes := zapi.MediaTypes{{
+		Name:   fmt.Sprintf("zabbix-testing-%d", rand.Int()),
+		Type:   zapi.MediaTypeWebhook,
+		Script: "return 'OK';",
+		Parameters: zapi.MediaTypeParameters{
+			{Name: "Message", Value: "{ALERT.MESSAGE}"},
+		},
+		MessageTemplates: zapi.MessageTemplates{
+			{EventSource: zapi.EventSourceTrigger, Recovery: zapi.MessageProblem, Subject: "Problem: {EVENT.NAME}"},
+		},
+	}}
+	err := getAPI(t).MediaTypesCreate(mediaTypes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &mediaTypes[0]
+}
+
+func DeleteMediaType(mediaType *zapi.MediaType, t *testing.T) {
+	err := getAPI(t).MediaTypesDelete(zapi.MediaTypes{*mediaType})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMediaTypes(t *testing.T) {
+	api := getAPI(t)
+
+	mediaType := CreateMediaType(t)
+	if mediaType.MediaTypeID == "" {
+		t.Errorf("Id is empty: %#v", mediaType)
+	}
+
+	mediaType2, err := api.MediaTypeGetByID(mediaType.MediaTypeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mediaType2.Type != zapi.MediaTypeWebhook || len(mediaType2.Parameters) != 1 {
+		t.Errorf("Bad media type: %#v", mediaType2)
+	}
+	if len(mediaType2.MessageTemplates) != 1 {
+		t.Errorf("Bad message templates: %#v", mediaType2.MessageTemplates)
+	}
+
+	mediaType.Description = "new description"
+	err = api.MediaTypesUpdate(zapi.MediaTypes{*mediaType})
+	if err != nil {
+		t.Error(err)
+	}
+
+	DeleteMediaType(mediaType, t)
+}
+
+func TestMediaSendTo(t *testing.T) {
+	var medias zapi.Medias
+	err := json.Unmarshal([]byte(`[{"mediatypeid":"1","sendto":["ops@example.com"],"active":"0","severity":"63"},`+
+		`{"mediatypeid":"3","sendto":"+15550100","active":"0","severity":"63"}]`), &medias)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if medias[0].SendToString || !medias[1].SendToString || medias[1].SendTo[0] != "+15550100" {
+		t.Errorf("Bad medias: %#v", medias)
+	}
+
+	asB, err := json.Marshal(medias)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(asB), `"sendto":["ops@example.com"]`) || !strings.Contains(string(asB), `"sendto":"+15550100"`) {
+		t.Errorf("Shape is not kept: %s", asB)
+	}
+}
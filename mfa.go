@@ -0,0 +1,125 @@
+package zabbix
+
+type (
+	// MFAType type of the multi-factor authentication method
+	// see "type" in https://www.zabbix.com/documentation/current/en/manual/api/reference/mfa/object
+	MFAType int
+
+	// MFAHashFunction hash function of TOTP codes
+	MFAHashFunction int
+)
+
+const (
+	MFATOTP MFAType = 1
+	MFADuo  MFAType = 2
+)
+
+const (
+	MFASHA1   MFAHashFunction = 1
+	MFASHA256 MFAHashFunction = 2
+	MFASHA512 MFAHashFunction = 3
+)
+
+// MFA represent Zabbix MFA method object, available since 7.0
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/mfa/object
+type MFA struct {
+	MFAID string  `json:"mfaid,omitempty"`
+	Type  MFAType `json:"type,string"`
+	Name  string  `json:"name"`
+
+	// TOTP fields
+	HashFunction MFAHashFunction `json:"hash_function,omitempty,string"`
+	CodeLength   string          `json:"code_length,omitempty"`
+
+	// Duo fields
+	APIHostname string `json:"api_hostname,omitempty"`
+	ClientID    string `json:"clientid,omitempty"`
+	// ClientSecret write only
+	ClientSecret string `json:"client_secret,omitempty"`
+}
+
+// MFAs is an array of MFA
+type MFAs []MFA
+
+// MFAsGet Wrapper for mfa.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/mfa/get
+func (api *API) MFAsGet(params Params) (res MFAs, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("mfa.get", params, &res)
+	return
+}
+
+// MFAGetByID Gets MFA method by Id only if there is exactly 1 matching method.
+func (api *API) MFAGetByID(id string) (res *MFA, err error) {
+	methods, err := api.MFAsGet(Params{"mfaids": id})
+	if err != nil {
+		return
+	}
+
+	if len(methods) == 1 {
+		res = &methods[0]
+	} else {
+		e := ExpectedOneResult(len(methods))
+		err = &e
+	}
+	return
+}
+
+// MFAsCreate Wrapper for mfa.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/mfa/create
+func (api *API) MFAsCreate(methods MFAs) (err error) {
+	response, err := api.CallWithError("mfa.create", methods)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	mfaids := result["mfaids"].([]interface{})
+	for i, id := range mfaids {
+		methods[i].MFAID = id.(string)
+	}
+	return
+}
+
+// MFAsUpdate Wrapper for mfa.update
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/mfa/update
+func (api *API) MFAsUpdate(methods MFAs) (err error) {
+	_, err = api.CallWithError("mfa.update", methods)
+	return
+}
+
+// MFAsDelete Wrapper for mfa.delete
+// Cleans MFAID in all methods elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/mfa/delete
+func (api *API) MFAsDelete(methods MFAs) (err error) {
+	ids := make([]string, len(methods))
+	for i, method := range methods {
+		ids[i] = method.MFAID
+	}
+
+	err = api.MFAsDeleteByIds(ids)
+	if err == nil {
+		for i := range methods {
+			methods[i].MFAID = ""
+		}
+	}
+	return
+}
+
+// MFAsDeleteByIds Wrapper for mfa.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/mfa/delete
+func (api *API) MFAsDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("mfa.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	mfaids := result["mfaids"].([]interface{})
+	if len(ids) != len(mfaids) {
+		err = &ExpectedMore{len(ids), len(mfaids)}
+	}
+	return
+}
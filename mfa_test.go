@@ -0,0 +1,79 @@
+package zabbix_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestMFAs(t *testing.T) {
+	api := getAPI(t)
+	if api.Config.Version < 70000 {
+		t.Skip("MFA methods are available since 7.0")
+	}
+
+	methods := zapi.MFAs{
+		{
+			Type:         zapi.MFATOTP,
+			Name:         fmt.Sprintf("zabbix-testing-%d", rand.Int()),
+			HashFunction: zapi.MFASHA256,
+			CodeLength:   "8",
+		},
+		{
+			Type:         zapi.MFADuo,
+			Name:         fmt.Sprintf("zabbix-testing-%d", rand.Int()),
+			APIHostname:  "api-12345678.duosecurity.com",
+			ClientID:     "DIWJ8X6AEYOR5OMC6TQ1",
+			ClientSecret: "Zh8gZTRSS3bnvm2PBGwzxN6OXr3pEOwQb4EwMF7P",
+		},
+	}
+	err := api.MFAsCreate(methods)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if methods[0].MFAID == "" || methods[1].MFAID == "" {
+		t.Fatalf("Id is empty: %#v", methods)
+	}
+
+	totp, err := api.MFAGetByID(methods[0].MFAID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totp.Type != zapi.MFATOTP || totp.HashFunction != zapi.MFASHA256 || totp.CodeLength != "8" {
+		t.Errorf("Bad TOTP method: %#v", totp)
+	}
+
+	duo, err := api.MFAGetByID(methods[1].MFAID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if duo.Type != zapi.MFADuo || duo.APIHostname != methods[1].APIHostname || duo.ClientID != methods[1].ClientID {
+		t.Errorf("Bad Duo method: %#v", duo)
+	}
+	if duo.ClientSecret != "" {
+		t.Errorf("Client secret is returned: %#v", duo)
+	}
+
+	totp.CodeLength = "6"
+	err = api.MFAsUpdate(zapi.MFAs{*totp})
+	if err != nil {
+		t.Fatal(err)
+	}
+	totp, err = api.MFAGetByID(methods[0].MFAID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totp.CodeLength != "6" {
+		t.Errorf("TOTP method is not updated: %#v", totp)
+	}
+
+	err = api.MFAsDelete(methods)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if methods[0].MFAID != "" || methods[1].MFAID != "" {
+		t.Errorf("Id is not cleaned: %#v", methods)
+	}
+}
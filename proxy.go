@@ -1,10 +1,24 @@
 package zabbix
 
-// Proxy represent Zabbix proxy object
+import (
+	"encoding/json"
+	"net"
+)
+
+const (
+	// ProxyActive active proxy, operating mode "0" since 7.0
+	ProxyActive = 5
+	// ProxyPassive passive proxy, operating mode "1" since 7.0
+	ProxyPassive = 6
+)
+
+// Proxy represent Zabbix proxy object, fields are mapped to the 7.0 schema by the server version
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/proxy/object#proxy
 type Proxy struct {
-	ProxyID        string `json:"proxyid,omitempty"`
-	Host           string `json:"host"`
+	ProxyID string `json:"proxyid,omitempty"`
+	// Host name of the proxy, "name" since 7.0
+	Host string `json:"host"`
+	// Status ProxyActive or ProxyPassive, "operating_mode" since 7.0, zero is not sent
 	Status         int    `json:"status,string"`
 	Description    string `json:"description,omitempty"`
 	TLSConnect     int    `json:"tls_connect,omitempty,string"`
@@ -13,20 +27,161 @@ type Proxy struct {
 	TLSSubject     string `json:"tls_subject,omitempty"`
 	TLSPSKIdentity string `json:"tls_psk_identity,omitempty"`
 	TLSPSK         string `json:"tls_psk,omitempty"`
-	ProxyAddress   string `json:"proxy_address,omitempty"`
+	// ProxyAddress addresses accepted from active proxy, "allowed_addresses" since 7.0
+	ProxyAddress string `json:"proxy_address,omitempty"`
+
+	// Address and Port of passive proxy, IP or DNS name of the proxy interface before 7.0
+	Address string `json:"address,omitempty"`
+	Port    string `json:"port,omitempty"`
+
+	// since 7.0
+	ProxyGroupID         string `json:"proxy_groupid,omitempty"`
+	LocalAddress         string `json:"local_address,omitempty"`
+	LocalPort            string `json:"local_port,omitempty"`
+	CustomTimeouts       string `json:"custom_timeouts,omitempty"`
+	TimeoutZabbixAgent   string `json:"timeout_zabbix_agent,omitempty"`
+	TimeoutSimpleCheck   string `json:"timeout_simple_check,omitempty"`
+	TimeoutSNMPAgent     string `json:"timeout_snmp_agent,omitempty"`
+	TimeoutExternalCheck string `json:"timeout_external_check,omitempty"`
+	TimeoutDBMonitor     string `json:"timeout_db_monitor,omitempty"`
+	TimeoutHTTPAgent     string `json:"timeout_http_agent,omitempty"`
+	TimeoutSSHAgent      string `json:"timeout_ssh_agent,omitempty"`
+	TimeoutTelnetAgent   string `json:"timeout_telnet_agent,omitempty"`
+	TimeoutScript        string `json:"timeout_script,omitempty"`
+	TimeoutBrowser       string `json:"timeout_browser,omitempty"`
+
+	// read only
+	LastAccess    string `json:"lastaccess,omitempty"`
+	Version       string `json:"version,omitempty"`
+	Compatibility string `json:"compatibility,omitempty"`
+	State         string `json:"state,omitempty"`
 }
 
 // Proxies is an array of Proxy
 type Proxies []Proxy
 
+// proxy fields renamed in 7.0
+var proxyFields70 = map[string]string{
+	"host":          "name",
+	"status":        "operating_mode",
+	"proxy_address": "allowed_addresses",
+}
+
+// proxy fields available since 7.0
+var proxyOnlyFields70 = []string{
+	"proxy_groupid", "local_address", "local_port", "custom_timeouts",
+	"timeout_zabbix_agent", "timeout_simple_check", "timeout_snmp_agent", "timeout_external_check",
+	"timeout_db_monitor", "timeout_http_agent", "timeout_ssh_agent", "timeout_telnet_agent",
+	"timeout_script", "timeout_browser", "state",
+}
+
+// proxyInterface passive proxy interface before 7.0
+type proxyInterface struct {
+	UseIP string `json:"useip"`
+	IP    string `json:"ip"`
+	DNS   string `json:"dns"`
+	Port  string `json:"port"`
+}
+
+// prepProxies converts the proxies to objects of the server version schema without read only fields.
+func (api *API) prepProxies(proxies Proxies) (res []Params, err error) {
+	res = make([]Params, len(proxies))
+	for i, proxy := range proxies {
+		proxy.LastAccess, proxy.Version, proxy.Compatibility, proxy.State = "", "", "", ""
+		var params Params
+		if params, err = objectParams(proxy); err != nil {
+			return
+		}
+
+		if api.Config.Version >= 70000 {
+			for old, name := range proxyFields70 {
+				if v, present := params[old]; present {
+					params[name] = v
+					delete(params, old)
+				}
+			}
+			delete(params, "operating_mode")
+			switch proxy.Status {
+			case ProxyActive:
+				params["operating_mode"] = "0"
+			case ProxyPassive:
+				params["operating_mode"] = "1"
+			}
+		} else {
+			if proxy.Status == 0 {
+				delete(params, "status")
+			}
+			for _, name := range proxyOnlyFields70 {
+				delete(params, name)
+			}
+			delete(params, "address")
+			delete(params, "port")
+			if proxy.Status == ProxyPassive && proxy.Address != "" {
+				in := proxyInterface{UseIP: "0", DNS: proxy.Address, Port: proxy.Port}
+				if net.ParseIP(proxy.Address) != nil {
+					in = proxyInterface{UseIP: "1", IP: proxy.Address, Port: proxy.Port}
+				}
+				params["interface"] = in
+			}
+		}
+		res[i] = params
+	}
+	return
+}
+
+// proxiesUnmarshal reads proxies of the server version schema.
+func (api *API) proxiesUnmarshal(raw []Params) (res Proxies, err error) {
+	for _, params := range raw {
+		if api.Config.Version >= 70000 {
+			for old, name := range proxyFields70 {
+				if v, present := params[name]; present {
+					params[old] = v
+					delete(params, name)
+				}
+			}
+			if mode, present := params["status"]; present {
+				if mode == "1" {
+					params["status"] = "6"
+				} else {
+					params["status"] = "5"
+				}
+			}
+		} else if in, ok := params["interface"].(map[string]interface{}); ok {
+			if in["useip"] == "1" {
+				params["address"] = in["ip"]
+			} else {
+				params["address"] = in["dns"]
+			}
+			params["port"] = in["port"]
+		}
+		delete(params, "interface")
+	}
+
+	asB, err := json.Marshal(raw)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(asB, &res)
+	return
+}
+
 // ProxiesGet Wrapper for proxy.get
+// Interface of passive proxies is selected before 7.0 to fill Address and Port.
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/proxy/get
 func (api *API) ProxiesGet(params Params) (res Proxies, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("proxy.get", params, &res)
-	return
+	if _, present := params["selectInterface"]; !present && api.Config.Version < 70000 {
+		params["selectInterface"] = "extend"
+	}
+
+	var raw []Params
+	err = api.CallWithErrorParse("proxy.get", params, &raw)
+	if err != nil {
+		return
+	}
+	return api.proxiesUnmarshal(raw)
 }
 
 // ProxyGetByID Gets user by Id only if there is exactly 1 matching proxy.
@@ -48,7 +203,11 @@ func (api *API) ProxyGetByID(id string) (res *Proxy, err error) {
 // ProxiesCreate Wrapper for proxy.create
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/proxy/create
 func (api *API) ProxiesCreate(Proxies Proxies) (err error) {
-	response, err := api.CallWithError("proxy.create", Proxies)
+	params, err := api.prepProxies(Proxies)
+	if err != nil {
+		return
+	}
+	response, err := api.CallWithError("proxy.create", params)
 	if err != nil {
 		return
 	}
@@ -64,7 +223,11 @@ func (api *API) ProxiesCreate(Proxies Proxies) (err error) {
 // ProxiesUpdate Wrapper for proxy.update
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/proxy/update
 func (api *API) ProxiesUpdate(Proxies Proxies) (err error) {
-	_, err = api.CallWithError("proxy.update", Proxies)
+	params, err := api.prepProxies(Proxies)
+	if err != nil {
+		return
+	}
+	_, err = api.CallWithError("proxy.update", params)
 	return
 }
 
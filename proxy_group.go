@@ -0,0 +1,112 @@
+package zabbix
+
+// ProxyGroup represent Zabbix proxy group object, available since 7.0
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/proxygroup/object
+type ProxyGroup struct {
+	ProxyGroupID string `json:"proxy_groupid,omitempty"`
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	// FailoverDelay time before hosts of an offline proxy are moved to others, e.g. "1m"
+	FailoverDelay string `json:"failover_delay,omitempty"`
+	// MinOnline minimum number of online proxies for the group to be online
+	MinOnline string `json:"min_online,omitempty"`
+
+	// read only, "0" unknown, "1" offline, "2" recovering, "3" online, "4" degrading
+	State string `json:"state,omitempty"`
+}
+
+// ProxyGroups is an array of ProxyGroup
+type ProxyGroups []ProxyGroup
+
+// prepProxyGroups returns copies of the proxy groups without read only fields.
+func prepProxyGroups(groups ProxyGroups) ProxyGroups {
+	res := make(ProxyGroups, len(groups))
+	for i, group := range groups {
+		group.State = ""
+		res[i] = group
+	}
+	return res
+}
+
+// ProxyGroupsGet Wrapper for proxygroup.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/proxygroup/get
+func (api *API) ProxyGroupsGet(params Params) (res ProxyGroups, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("proxygroup.get", params, &res)
+	return
+}
+
+// ProxyGroupGetByID Gets proxy group by Id only if there is exactly 1 matching proxy group.
+func (api *API) ProxyGroupGetByID(id string) (res *ProxyGroup, err error) {
+	groups, err := api.ProxyGroupsGet(Params{"proxy_groupids": id})
+	if err != nil {
+		return
+	}
+
+	if len(groups) == 1 {
+		res = &groups[0]
+	} else {
+		e := ExpectedOneResult(len(groups))
+		err = &e
+	}
+	return
+}
+
+// ProxyGroupsCreate Wrapper for proxygroup.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/proxygroup/create
+func (api *API) ProxyGroupsCreate(groups ProxyGroups) (err error) {
+	response, err := api.CallWithError("proxygroup.create", prepProxyGroups(groups))
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	proxyGroupids := result["proxy_groupids"].([]interface{})
+	for i, id := range proxyGroupids {
+		groups[i].ProxyGroupID = id.(string)
+	}
+	return
+}
+
+// ProxyGroupsUpdate Wrapper for proxygroup.update
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/proxygroup/update
+func (api *API) ProxyGroupsUpdate(groups ProxyGroups) (err error) {
+	_, err = api.CallWithError("proxygroup.update", prepProxyGroups(groups))
+	return
+}
+
+// ProxyGroupsDelete Wrapper for proxygroup.delete
+// Cleans ProxyGroupID in all groups elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/proxygroup/delete
+func (api *API) ProxyGroupsDelete(groups ProxyGroups) (err error) {
+	ids := make([]string, len(groups))
+	for i, group := range groups {
+		ids[i] = group.ProxyGroupID
+	}
+
+	err = api.ProxyGroupsDeleteByIds(ids)
+	if err == nil {
+		for i := range groups {
+			groups[i].ProxyGroupID = ""
+		}
+	}
+	return
+}
+
+// ProxyGroupsDeleteByIds Wrapper for proxygroup.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/proxygroup/delete
+func (api *API) ProxyGroupsDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("proxygroup.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	proxyGroupids := result["proxy_groupids"].([]interface{})
+	if len(ids) != len(proxyGroupids) {
+		err = &ExpectedMore{len(ids), len(proxyGroupids)}
+	}
+	return
+}
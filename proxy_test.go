@@ -0,0 +1,89 @@
+package zabbix_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestProxies(t *testing.T) {
+	api := getAPI(t)
+
+	proxies := zapi.Proxies{{
+		Host:    fmt.Sprintf("%s-%d", getHost(), rand.Int()),
+		Status:  zapi.ProxyPassive,
+		Address: "127.0.0.1",
+		Port:    "10051",
+	}}
+
+	var groups zapi.ProxyGroups
+	if api.Config.Version >= 70000 {
+		groups = zapi.ProxyGroups{{Name: fmt.Sprintf("%s-%d", getHost(), rand.Int()), FailoverDelay: "1m", MinOnline: "1"}}
+		err := api.ProxyGroupsCreate(groups)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer api.ProxyGroupsDelete(groups)
+		proxies[0].ProxyGroupID = groups[0].ProxyGroupID
+		proxies[0].LocalAddress = "127.0.0.1"
+	}
+
+	err := api.ProxiesCreate(proxies)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer api.ProxiesDelete(proxies)
+
+	proxy, err := api.ProxyGetByID(proxies[0].ProxyID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proxy.Host != proxies[0].Host || proxy.Status != zapi.ProxyPassive || proxy.Address != "127.0.0.1" || proxy.Port != "10051" {
+		t.Errorf("Bad proxy: %#v", proxy)
+	}
+	if proxy.ProxyGroupID != proxies[0].ProxyGroupID {
+		t.Errorf("Bad proxy group: %#v", proxy)
+	}
+
+	proxy.Description = "updated"
+	err = api.ProxiesUpdate(zapi.Proxies{*proxy})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// zero status is not sent, the proxy stays passive
+	err = api.ProxiesUpdate(zapi.Proxies{{ProxyID: proxy.ProxyID, Host: proxy.Host, Description: "status kept"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy2, err := api.ProxyGetByID(proxy.ProxyID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proxy2.Status != zapi.ProxyPassive || proxy2.Description != "status kept" {
+		t.Errorf("Bad proxy: %#v", proxy2)
+	}
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+	host := CreateHost(group, t)
+	defer DeleteHost(host, t)
+
+	host.ProxyID = proxy.ProxyID
+	err = api.HostsUpdate(zapi.Hosts{*host})
+	if err != nil {
+		t.Fatal(err)
+	}
+	host2, err := api.HostGetByID(host.HostID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if host2.ProxyID != proxy.ProxyID {
+		t.Errorf("Bad host proxy: %#v", host2)
+	}
+	if api.Config.Version >= 70000 && host2.MonitoredBy != zapi.MonitoredByProxy {
+		t.Errorf("Bad host monitoring: %#v", host2)
+	}
+}
@@ -0,0 +1,220 @@
+package zabbix
+
+import (
+	"regexp"
+	"strings"
+)
+
+// RegexpExpressionType type of a global regular expression
+// see "expression_type" in https://www.zabbix.com/documentation/current/en/manual/api/reference/regexp/object
+type RegexpExpressionType int
+
+const (
+	// RegexpIncluded string contains the expression
+	RegexpIncluded RegexpExpressionType = 0
+	// RegexpAnyIncluded string contains any of the substrings of the expression split by Delimiter
+	RegexpAnyIncluded RegexpExpressionType = 1
+	// RegexpNotIncluded string does not contain the expression
+	RegexpNotIncluded RegexpExpressionType = 2
+	// RegexpTrue string matches the regular expression
+	RegexpTrue RegexpExpressionType = 3
+	// RegexpFalse string does not match the regular expression
+	RegexpFalse RegexpExpressionType = 4
+)
+
+// RegexpExpression represent Zabbix expression of a global regular expression
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/regexp/object#expressions
+type RegexpExpression struct {
+	Expression string               `json:"expression"`
+	Type       RegexpExpressionType `json:"expression_type,string"`
+	// Delimiter "," (default), "." or "/", used by RegexpAnyIncluded only
+	Delimiter string `json:"exp_delimiter,omitempty"`
+	// CaseSensitive "0" case insensitive (default), "1" case sensitive
+	CaseSensitive string `json:"case_sensitive,omitempty"`
+}
+
+// RegexpExpressions is an array of RegexpExpression
+type RegexpExpressions []RegexpExpression
+
+// Regexp represent Zabbix global regular expression object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/regexp/object
+type Regexp struct {
+	RegexpID    string            `json:"regexpid,omitempty"`
+	Name        string            `json:"name"`
+	TestString  string            `json:"test_string,omitempty"`
+	Expressions RegexpExpressions `json:"expressions,omitempty"`
+}
+
+// Regexps is an array of Regexp
+type Regexps []Regexp
+
+// asciiLower lowers ASCII letters only, as case insensitive string search of Zabbix does.
+func asciiLower(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if 'A' <= c && c <= 'Z' {
+			b[i] = c + 'a' - 'A'
+		}
+	}
+	return string(b)
+}
+
+// Match reports whether the string matches the expression.
+// Regular expressions are compiled in multi-line mode with Go regexp package, which implements RE2
+// rather than PCRE used by Zabbix: lookarounds, backreferences and other PCRE only syntax fail
+// to compile, so results may differ from Zabbix for such expressions.
+func (e RegexpExpression) Match(s string) (bool, error) {
+	caseSensitive := e.CaseSensitive == "1"
+
+	switch e.Type {
+	case RegexpTrue, RegexpFalse:
+		flags := "(?m)"
+		if !caseSensitive {
+			flags = "(?im)"
+		}
+		expr := flags + e.Expression
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(s) == (e.Type == RegexpTrue), nil
+	}
+
+	expr := e.Expression
+	if !caseSensitive {
+		s = asciiLower(s)
+		expr = asciiLower(expr)
+	}
+
+	switch e.Type {
+	case RegexpIncluded:
+		return strings.Contains(s, expr), nil
+	case RegexpNotIncluded:
+		return !strings.Contains(s, expr), nil
+	case RegexpAnyIncluded:
+		delimiter := e.Delimiter
+		if delimiter == "" {
+			delimiter = ","
+		}
+		subs := strings.Split(expr, delimiter)
+		// trailing delimiter doesn't add an empty substring matching everything
+		if len(subs) > 1 && subs[len(subs)-1] == "" {
+			subs = subs[:len(subs)-1]
+		}
+		for _, sub := range subs {
+			if strings.Contains(s, sub) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// Match reports whether the string matches the global regular expression,
+// all expressions must match as in Zabbix. Expressions must be fetched,
+// a regexp without expressions matches nothing. See RegexpExpression.Match for RE2 limitations.
+func (r Regexp) Match(s string) (bool, error) {
+	if len(r.Expressions) == 0 {
+		return false, nil
+	}
+	for _, e := range r.Expressions {
+		matched, err := e.Match(s)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// RegexpsGet Wrapper for regexp.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/regexp/get
+func (api *API) RegexpsGet(params Params) (res Regexps, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("regexp.get", params, &res)
+	return
+}
+
+// RegexpGetByID Gets global regular expression with its expressions by Id only if there is exactly 1 matching regexp.
+func (api *API) RegexpGetByID(id string) (res *Regexp, err error) {
+	return api.regexpGetOne(Params{"regexpids": id, "selectExpressions": "extend"})
+}
+
+// RegexpGetByName Gets global regular expression with its expressions by name, as referenced with "@" in filters.
+func (api *API) RegexpGetByName(name string) (res *Regexp, err error) {
+	name = strings.TrimPrefix(name, "@")
+	return api.regexpGetOne(Params{"filter": Params{"name": name}, "selectExpressions": "extend"})
+}
+
+func (api *API) regexpGetOne(params Params) (res *Regexp, err error) {
+	regexps, err := api.RegexpsGet(params)
+	if err != nil {
+		return
+	}
+
+	if len(regexps) == 1 {
+		res = &regexps[0]
+	} else {
+		e := ExpectedOneResult(len(regexps))
+		err = &e
+	}
+	return
+}
+
+// RegexpsCreate Wrapper for regexp.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/regexp/create
+func (api *API) RegexpsCreate(regexps Regexps) (err error) {
+	response, err := api.CallWithError("regexp.create", regexps)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	regexpids := result["regexpids"].([]interface{})
+	for i, id := range regexpids {
+		regexps[i].RegexpID = id.(string)
+	}
+	return
+}
+
+// RegexpsUpdate Wrapper for regexp.update
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/regexp/update
+func (api *API) RegexpsUpdate(regexps Regexps) (err error) {
+	_, err = api.CallWithError("regexp.update", regexps)
+	return
+}
+
+// RegexpsDelete Wrapper for regexp.delete
+// Cleans RegexpID in all regexps elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/regexp/delete
+func (api *API) RegexpsDelete(regexps Regexps) (err error) {
+	ids := make([]string, len(regexps))
+	for i, r := range regexps {
+		ids[i] = r.RegexpID
+	}
+
+	err = api.RegexpsDeleteByIds(ids)
+	if err == nil {
+		for i := range regexps {
+			regexps[i].RegexpID = ""
+		}
+	}
+	return
+}
+
+// RegexpsDeleteByIds Wrapper for regexp.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/regexp/delete
+func (api *API) RegexpsDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("regexp.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	regexpids := result["regexpids"].([]interface{})
+	if len(ids) != len(regexpids) {
+		err = &ExpectedMore{len(ids), len(regexpids)}
+	}
+	return
+}
@@ -0,0 +1,117 @@
+package zabbix_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestRegexpMatch(t *testing.T) {
+	fs := zapi.Regexp{
+		Name: "File systems for discovery",
+		Expressions: zapi.RegexpExpressions{
+			{Expression: "^(btrfs|ext2|ext3|ext4|xfs)$", Type: zapi.RegexpTrue},
+		},
+	}
+	for s, expected := range map[string]bool{"ext4": true, "XFS": true, "tmpfs": false} {
+		if matched, err := fs.Match(s); err != nil || matched != expected {
+			t.Errorf("%s: expected %v, got %v (%v)", s, expected, matched, err)
+		}
+	}
+
+	r := zapi.Regexp{
+		Expressions: zapi.RegexpExpressions{
+			{Expression: "eth/ens", Type: zapi.RegexpAnyIncluded, Delimiter: "/", CaseSensitive: "1"},
+			{Expression: "VLAN", Type: zapi.RegexpNotIncluded},
+			{Expression: `\d$`, Type: zapi.RegexpTrue},
+			{Expression: "^lo", Type: zapi.RegexpFalse},
+		},
+	}
+	for s, expected := range map[string]bool{"eth0": true, "ens3": true, "ETH0": false, "eth0.vlan5": false, "eth": false} {
+		if matched, err := r.Match(s); err != nil || matched != expected {
+			t.Errorf("%s: expected %v, got %v (%v)", s, expected, matched, err)
+		}
+	}
+
+	multiline := zapi.Regexp{Expressions: zapi.RegexpExpressions{{Expression: "^error", Type: zapi.RegexpTrue}}}
+	if matched, err := multiline.Match("ok\nerror: disk full"); err != nil || !matched {
+		t.Errorf("Expression must match any line, got %v (%v)", matched, err)
+	}
+
+	trailing := zapi.Regexp{Expressions: zapi.RegexpExpressions{{Expression: "eth,ens,", Type: zapi.RegexpAnyIncluded}}}
+	if matched, err := trailing.Match("lo"); err != nil || matched {
+		t.Errorf("Trailing delimiter must not match everything, got %v (%v)", matched, err)
+	}
+
+	if matched, err := (zapi.Regexp{}).Match("x"); err != nil || matched {
+		t.Errorf("Regexp without expressions must not match, got %v (%v)", matched, err)
+	}
+
+	// RE2 does not support lookarounds
+	lookahead := zapi.Regexp{Expressions: zapi.RegexpExpressions{{Expression: "eth(?!0)", Type: zapi.RegexpTrue}}}
+	if _, err := lookahead.Match("eth1"); err == nil {
+		t.Error("PCRE only syntax must fail")
+	}
+
+	bad := zapi.Regexp{Expressions: zapi.RegexpExpressions{{Expression: "(", Type: zapi.RegexpTrue}}}
+	if _, err := bad.Match("x"); err == nil {
+		t.Error("Invalid expression must fail")
+	}
+}
+
+func TestRegexps(t *testing.T) {
+	api := getAPI(t)
+	if api.Config.Version < 60000 {
+		t.Skip("regexp API is available since 6.0")
+	}
+
+	regexps := zapi.Regexps{{
+		Name:        fmt.Sprintf("zabbix-testing-%d", rand.Int()),
+		Expressions: zapi.RegexpExpressions{{Expression: "^eth", Type: zapi.RegexpTrue}},
+	}}
+	err := api.RegexpsCreate(regexps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if regexps[0].RegexpID == "" {
+		t.Fatalf("Id is empty: %#v", regexps[0])
+	}
+
+	r, err := api.RegexpGetByName("@" + regexps[0].Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.RegexpID != regexps[0].RegexpID || len(r.Expressions) != 1 {
+		t.Fatalf("Bad regexp: %#v", r)
+	}
+	if matched, err := r.Match("eth0"); err != nil || !matched {
+		t.Errorf("Fetched regexp does not match: %v %v", matched, err)
+	}
+
+	r.Expressions = append(r.Expressions, zapi.RegexpExpression{Expression: "VLAN", Type: zapi.RegexpNotIncluded})
+	err = api.RegexpsUpdate(zapi.Regexps{*r})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err = api.RegexpGetByID(regexps[0].RegexpID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Expressions) != 2 {
+		t.Fatalf("Regexp is not updated: %#v", r)
+	}
+	if matched, err := r.Match("eth0.vlan5"); err != nil || matched {
+		t.Errorf("Updated regexp matches: %v %v", matched, err)
+	}
+
+	err = api.RegexpsDelete(regexps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if regexps[0].RegexpID != "" {
+		t.Errorf("Id is not cleaned: %#v", regexps[0])
+	}
+}
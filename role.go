@@ -0,0 +1,202 @@
+package zabbix
+
+import "strings"
+
+type (
+	// RoleType user type the role grants
+	// see "type" in https://www.zabbix.com/documentation/current/en/manual/api/reference/role/object
+	RoleType int
+
+	// RoleAPIMode how the API method list of a role is used
+	RoleAPIMode string
+)
+
+const (
+	RoleUser       RoleType = 1
+	RoleAdmin      RoleType = 2
+	RoleSuperAdmin RoleType = 3
+)
+
+const (
+	// RoleAPIDenyList methods of the list are denied (default)
+	RoleAPIDenyList RoleAPIMode = "0"
+	// RoleAPIAllowList only methods of the list are allowed
+	RoleAPIAllowList RoleAPIMode = "1"
+)
+
+// RoleRule enables or disables access to an UI element, module or action, status "0" disabled, "1" enabled
+type RoleRule struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// RoleModuleRule enables or disables access to a frontend module
+type RoleModuleRule struct {
+	ModuleID string `json:"moduleid"`
+	Status   string `json:"status"`
+}
+
+// RoleServiceTag grants access to services by tag
+type RoleServiceTag struct {
+	Tag   string `json:"tag"`
+	Value string `json:"value,omitempty"`
+}
+
+// RoleRules represent Zabbix role rules object, flag values are "0" disabled and "1" enabled
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/role/object#role-rules
+type RoleRules struct {
+	UI              []RoleRule `json:"ui,omitempty"`
+	UIDefaultAccess string     `json:"ui.default_access,omitempty"`
+
+	// services access since 6.0, mode "0" is access to listed or tagged services only, "1" all services
+	ServicesReadMode  string          `json:"services.read.mode,omitempty"`
+	ServicesReadList  ServiceIDs      `json:"services.read.list,omitempty"`
+	ServicesReadTag   *RoleServiceTag `json:"services.read.tag,omitempty"`
+	ServicesWriteMode string          `json:"services.write.mode,omitempty"`
+	ServicesWriteList ServiceIDs      `json:"services.write.list,omitempty"`
+	ServicesWriteTag  *RoleServiceTag `json:"services.write.tag,omitempty"`
+
+	Modules              []RoleModuleRule `json:"modules,omitempty"`
+	ModulesDefaultAccess string           `json:"modules.default_access,omitempty"`
+
+	APIAccess string      `json:"api.access,omitempty"`
+	APIMode   RoleAPIMode `json:"api.mode,omitempty"`
+	// API methods, may contain wildcards like "host.*", "*.get" or "*.*"
+	API []string `json:"api,omitempty"`
+
+	Actions              []RoleRule `json:"actions,omitempty"`
+	ActionsDefaultAccess string     `json:"actions.default_access,omitempty"`
+}
+
+// Role represent Zabbix user role object, available since 5.2
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/role/object
+type Role struct {
+	RoleID   string     `json:"roleid,omitempty"`
+	Name     string     `json:"name"`
+	Type     RoleType   `json:"type,string"`
+	ReadOnly string     `json:"readonly,omitempty"`
+	Rules    *RoleRules `json:"rules,omitempty"`
+}
+
+// Roles is an array of Role
+type Roles []Role
+
+// apiMethodMatch matches API method against a pattern of the role API list.
+func apiMethodMatch(pattern, method string) bool {
+	p := strings.SplitN(strings.ToLower(pattern), ".", 2)
+	m := strings.SplitN(strings.ToLower(method), ".", 2)
+	if len(p) != 2 || len(m) != 2 {
+		return false
+	}
+	return (p[0] == "*" || p[0] == m[0]) && (p[1] == "*" || p[1] == m[1])
+}
+
+// APIRulesAllow reports whether the API rules of the role allow calling the method.
+// Rules must be fetched. Only the API access and method list are checked, the method
+// may still be denied by the user type: many methods are for admins or super admins only.
+func (r Role) APIRulesAllow(method string) bool {
+	if r.Rules == nil || r.Rules.APIAccess == "0" {
+		return false
+	}
+
+	listed := false
+	for _, pattern := range r.Rules.API {
+		if apiMethodMatch(pattern, method) {
+			listed = true
+			break
+		}
+	}
+	if r.Rules.APIMode == RoleAPIAllowList {
+		return listed
+	}
+	return !listed
+}
+
+// RolesGet Wrapper for role.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/role/get
+func (api *API) RolesGet(params Params) (res Roles, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("role.get", params, &res)
+	return
+}
+
+// RoleGetByID Gets role with its rules by Id only if there is exactly 1 matching role.
+func (api *API) RoleGetByID(id string) (res *Role, err error) {
+	roles, err := api.RolesGet(Params{"roleids": id, "selectRules": "extend"})
+	if err != nil {
+		return
+	}
+
+	if len(roles) == 1 {
+		res = &roles[0]
+	} else {
+		e := ExpectedOneResult(len(roles))
+		err = &e
+	}
+	return
+}
+
+// RolesCreate Wrapper for role.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/role/create
+func (api *API) RolesCreate(roles Roles) (err error) {
+	response, err := api.CallWithError("role.create", roles)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	roleids := result["roleids"].([]interface{})
+	for i, id := range roleids {
+		roles[i].RoleID = id.(string)
+	}
+	return
+}
+
+// RolesUpdate Wrapper for role.update
+// Read only fields are not sent.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/role/update
+func (api *API) RolesUpdate(roles Roles) (err error) {
+	update := make(Roles, len(roles))
+	for i, role := range roles {
+		role.ReadOnly = ""
+		update[i] = role
+	}
+	_, err = api.CallWithError("role.update", update)
+	return
+}
+
+// RolesDelete Wrapper for role.delete
+// Cleans RoleID in all roles elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/role/delete
+func (api *API) RolesDelete(roles Roles) (err error) {
+	ids := make([]string, len(roles))
+	for i, role := range roles {
+		ids[i] = role.RoleID
+	}
+
+	err = api.RolesDeleteByIds(ids)
+	if err == nil {
+		for i := range roles {
+			roles[i].RoleID = ""
+		}
+	}
+	return
+}
+
+// RolesDeleteByIds Wrapper for role.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/role/delete
+func (api *API) RolesDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("role.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	roleids := result["roleids"].([]interface{})
+	if len(ids) != len(roleids) {
+		err = &ExpectedMore{len(ids), len(roleids)}
+	}
+	return
+}
@@ -0,0 +1,102 @@
+package zabbix_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestRoleAPIRulesAllow(t *testing.T) {
+	allow := zapi.Role{Rules: &zapi.RoleRules{
+		APIAccess: "1",
+		APIMode:   zapi.RoleAPIAllowList,
+		API:       []string{"host.*", "*.get", "token.generate"},
+	}}
+	deny := zapi.Role{Rules: &zapi.RoleRules{
+		APIAccess: "1",
+		APIMode:   zapi.RoleAPIDenyList,
+		API:       []string{"*.delete"},
+	}}
+	disabled := zapi.Role{Rules: &zapi.RoleRules{APIAccess: "0"}}
+
+	cases := []struct {
+		role   zapi.Role
+		method string
+		can    bool
+	}{
+		{allow, "host.create", true},
+		{allow, "item.get", true},
+		{allow, "Token.Generate", true},
+		{allow, "item.create", false},
+		{deny, "host.create", true},
+		{deny, "host.delete", false},
+		{disabled, "host.get", false},
+		{zapi.Role{}, "host.get", false},
+	}
+	for _, c := range cases {
+		if can := c.role.APIRulesAllow(c.method); can != c.can {
+			t.Errorf("APIRulesAllow(%s) = %v, expected %v for %#v", c.method, can, c.can, c.role.Rules)
+		}
+	}
+}
+
+func TestRoles(t *testing.T) {
+	api := getAPI(t)
+	if api.Config.Version < 50200 {
+		t.Skip("roles are available since 5.2")
+	}
+
+	roles := zapi.Roles{{
+		Name: fmt.Sprintf("zabbix-testing-%d", rand.Int()),
+		Type: zapi.RoleUser,
+		Rules: &zapi.RoleRules{
+			APIAccess: "1",
+			APIMode:   zapi.RoleAPIAllowList,
+			API:       []string{"host.get"},
+		},
+	}}
+	err := api.RolesCreate(roles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if roles[0].RoleID == "" {
+		t.Fatalf("Id is empty: %#v", roles[0])
+	}
+
+	role, err := api.RoleGetByID(roles[0].RoleID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if role.Type != zapi.RoleUser || role.Rules == nil || !role.APIRulesAllow("host.get") || role.APIRulesAllow("item.get") {
+		t.Errorf("Bad role: %#v", role)
+	}
+
+	role.Name += "-renamed"
+	role.Rules = &zapi.RoleRules{
+		APIAccess: "1",
+		APIMode:   zapi.RoleAPIAllowList,
+		API:       []string{"host.get", "item.get"},
+	}
+	err = api.RolesUpdate(zapi.Roles{*role})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	role, err = api.RoleGetByID(roles[0].RoleID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if role.Name != roles[0].Name+"-renamed" || !role.APIRulesAllow("item.get") {
+		t.Errorf("Role is not updated: %#v", role)
+	}
+
+	err = api.RolesDelete(roles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if roles[0].RoleID != "" {
+		t.Errorf("Id is not cleaned: %#v", roles[0])
+	}
+}
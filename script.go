@@ -0,0 +1,279 @@
+package zabbix
+
+type (
+	// ScriptType type of the script
+	// see "type" in https://www.zabbix.com/documentation/current/en/manual/api/reference/script/object
+	ScriptType int
+
+	// ScriptScope where the script can be used
+	ScriptScope int
+
+	// ScriptExecuteOn where the script is executed
+	ScriptExecuteOn int
+
+	// ScriptAuthType SSH authentication method
+	ScriptAuthType int
+)
+
+const (
+	ScriptCustom  ScriptType = 0
+	ScriptIPMI    ScriptType = 1
+	ScriptSSH     ScriptType = 2
+	ScriptTelnet  ScriptType = 3
+	ScriptWebhook ScriptType = 5
+	// ScriptURL opens URL, since 7.0
+	ScriptURL ScriptType = 6
+)
+
+const (
+	// ScriptScopeAction action operation
+	ScriptScopeAction ScriptScope = 1
+	// ScriptScopeHost manual host action
+	ScriptScopeHost ScriptScope = 2
+	// ScriptScopeEvent manual event action
+	ScriptScopeEvent ScriptScope = 4
+)
+
+const (
+	// ExecuteOnAgent Zabbix agent
+	ExecuteOnAgent ScriptExecuteOn = 0
+	// ExecuteOnServer Zabbix server
+	ExecuteOnServer ScriptExecuteOn = 1
+	// ExecuteOnProxy Zabbix server or proxy monitoring the host (default)
+	ExecuteOnProxy ScriptExecuteOn = 2
+)
+
+const (
+	ScriptAuthPassword  ScriptAuthType = 0
+	ScriptAuthPublicKey ScriptAuthType = 1
+)
+
+// ScriptParameter represents webhook script parameter
+type ScriptParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ScriptParameters is an array of ScriptParameter
+type ScriptParameters []ScriptParameter
+
+// Script represent Zabbix script object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/script/object
+type Script struct {
+	ScriptID    string          `json:"scriptid,omitempty"`
+	Name        string          `json:"name"`
+	Type        ScriptType      `json:"type,string"`
+	Scope       ScriptScope     `json:"scope,string"`
+	Command     string          `json:"command,omitempty"`
+	ExecuteOn   ScriptExecuteOn `json:"-"`
+	MenuPath    string          `json:"menu_path,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Timeout     string          `json:"timeout,omitempty"`
+
+	// ssh / telnet
+	AuthType   ScriptAuthType `json:"authtype,omitempty,string"`
+	Username   string         `json:"username,omitempty"`
+	Password   string         `json:"password,omitempty"`
+	PublicKey  string         `json:"publickey,omitempty"`
+	PrivateKey string         `json:"privatekey,omitempty"`
+	Port       string         `json:"port,omitempty"`
+
+	// access control for manual scripts
+	GroupID      string `json:"groupid,omitempty"`
+	UserGroupID  string `json:"usrgrpid,omitempty"`
+	HostAccess   string `json:"host_access,omitempty"`
+	Confirmation string `json:"confirmation,omitempty"`
+
+	// Webhook fields
+	Parameters ScriptParameters `json:"parameters,omitempty"`
+
+	// URL fields, since 7.0
+	Url       string `json:"url,omitempty"`
+	NewWindow string `json:"new_window,omitempty"`
+
+	// ExecuteOn is sent and read back from this one, only custom scripts have it
+	RawExecuteOn *ScriptExecuteOn `json:"execute_on,string,omitempty"`
+}
+
+// Scripts is an array of Script
+type Scripts []Script
+
+// ScriptDebugLog represents a log entry of webhook execution
+type ScriptDebugLog struct {
+	Level   int    `json:"level"`
+	Ms      int    `json:"ms"`
+	Message string `json:"message"`
+}
+
+// ScriptDebug represents debug information of webhook execution
+type ScriptDebug struct {
+	Logs []ScriptDebugLog `json:"logs"`
+	Ms   int              `json:"ms"`
+}
+
+// ScriptResult is the result of script.execute
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/script/execute
+type ScriptResult struct {
+	Response string       `json:"response"`
+	Value    string       `json:"value"`
+	Debug    *ScriptDebug `json:"debug,omitempty"`
+}
+
+// prepScripts returns copies of scripts with ExecuteOn set for custom scripts.
+func prepScripts(scripts Scripts) Scripts {
+	res := make(Scripts, len(scripts))
+	for i, script := range scripts {
+		script.RawExecuteOn = nil
+		if script.Type == ScriptCustom {
+			executeOn := script.ExecuteOn
+			script.RawExecuteOn = &executeOn
+		}
+		res[i] = script
+	}
+	return res
+}
+
+// scriptsUnmarshal fixes up ExecuteOn of scripts read from the API.
+func scriptsUnmarshal(scripts Scripts) {
+	for i := range scripts {
+		if scripts[i].RawExecuteOn != nil {
+			scripts[i].ExecuteOn = *scripts[i].RawExecuteOn
+			scripts[i].RawExecuteOn = nil
+		}
+	}
+}
+
+// ScriptsGet Wrapper for script.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/script/get
+func (api *API) ScriptsGet(params Params) (res Scripts, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("script.get", params, &res)
+	scriptsUnmarshal(res)
+	return
+}
+
+// ScriptGetByID Gets script by Id only if there is exactly 1 matching script.
+func (api *API) ScriptGetByID(id string) (res *Script, err error) {
+	scripts, err := api.ScriptsGet(Params{"scriptids": id})
+	if err != nil {
+		return
+	}
+
+	if len(scripts) == 1 {
+		res = &scripts[0]
+	} else {
+		e := ExpectedOneResult(len(scripts))
+		err = &e
+	}
+	return
+}
+
+// ScriptsCreate Wrapper for script.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/script/create
+func (api *API) ScriptsCreate(scripts Scripts) (err error) {
+	response, err := api.CallWithError("script.create", prepScripts(scripts))
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	scriptids := result["scriptids"].([]interface{})
+	for i, id := range scriptids {
+		scripts[i].ScriptID = id.(string)
+	}
+	return
+}
+
+// ScriptsUpdate Wrapper for script.update
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/script/update
+func (api *API) ScriptsUpdate(scripts Scripts) (err error) {
+	_, err = api.CallWithError("script.update", prepScripts(scripts))
+	return
+}
+
+// ScriptsDelete Wrapper for script.delete
+// Cleans ScriptID in all scripts elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/script/delete
+func (api *API) ScriptsDelete(scripts Scripts) (err error) {
+	ids := make([]string, len(scripts))
+	for i, script := range scripts {
+		ids[i] = script.ScriptID
+	}
+
+	err = api.ScriptsDeleteByIds(ids)
+	if err == nil {
+		for i := range scripts {
+			scripts[i].ScriptID = ""
+		}
+	}
+	return
+}
+
+// ScriptsDeleteByIds Wrapper for script.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/script/delete
+func (api *API) ScriptsDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("script.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	scriptids := result["scriptids"].([]interface{})
+	if len(ids) != len(scriptids) {
+		err = &ExpectedMore{len(ids), len(scriptids)}
+	}
+	return
+}
+
+// ScriptExecuteOnHost Wrapper for script.execute, runs the script on the host.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/script/execute
+func (api *API) ScriptExecuteOnHost(scriptID, hostID string) (res *ScriptResult, err error) {
+	res = &ScriptResult{}
+	err = api.CallWithErrorParse("script.execute", Params{"scriptid": scriptID, "hostid": hostID}, res)
+	return
+}
+
+// ScriptExecuteOnEvent Wrapper for script.execute, runs the script for the event.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/script/execute
+func (api *API) ScriptExecuteOnEvent(scriptID, eventID string) (res *ScriptResult, err error) {
+	res = &ScriptResult{}
+	err = api.CallWithErrorParse("script.execute", Params{"scriptid": scriptID, "eventid": eventID}, res)
+	return
+}
+
+// scriptsByIDsParams builds parameters of getscriptsbyhosts and getscriptsbyevents,
+// since 7.0 they take objects instead of plain IDs.
+func (api *API) scriptsByIDsParams(field string, ids []string) interface{} {
+	if api.Config.Version < 70000 {
+		return ids
+	}
+	params := make([]Params, len(ids))
+	for i, id := range ids {
+		params[i] = Params{field: id}
+	}
+	return params
+}
+
+// ScriptsGetByHosts Wrapper for script.getscriptsbyhosts
+// Returns scripts available for each host, keyed by host ID.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/script/getscriptsbyhosts
+func (api *API) ScriptsGetByHosts(hostIDs []string) (res map[string]Scripts, err error) {
+	err = api.CallWithErrorParse("script.getscriptsbyhosts", api.scriptsByIDsParams("hostid", hostIDs), &res)
+	for _, scripts := range res {
+		scriptsUnmarshal(scripts)
+	}
+	return
+}
+
+// ScriptsGetByEvents Wrapper for script.getscriptsbyevents
+// Returns scripts available for each event, keyed by event ID.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/script/getscriptsbyevents
+func (api *API) ScriptsGetByEvents(eventIDs []string) (res map[string]Scripts, err error) {
+	err = api.CallWithErrorParse("script.getscriptsbyevents", api.scriptsByIDsParams("eventid", eventIDs), &res)
+	for _, scripts := range res {
+		scriptsUnmarshal(scripts)
+	}
+	return
+}
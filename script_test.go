@@ -0,0 +1,61 @@
+package zabbix_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestScripts(t *testing.T) {
+	api := getAPI(t)
+
+	scripts := zapi.Scripts{
+		{
+			Name:      fmt.Sprintf("zabbix-testing-%d", rand.Int()),
+			Type:      zapi.ScriptCustom,
+			Scope:     zapi.ScriptScopeHost,
+			Command:   "uptime",
+			ExecuteOn: zapi.ExecuteOnAgent,
+		},
+		// webhooks don't have execute_on
+		{
+			Name:    fmt.Sprintf("zabbix-testing-%d", rand.Int()),
+			Type:    zapi.ScriptWebhook,
+			Scope:   zapi.ScriptScopeAction,
+			Command: "return 'ok';",
+		},
+	}
+	err := api.ScriptsCreate(scripts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer api.ScriptsDelete(scripts)
+	if scripts[0].ScriptID == "" || scripts[1].ScriptID == "" {
+		t.Fatalf("Id is empty: %#v", scripts)
+	}
+
+	// agent is not the default, it must be sent
+	script, err := api.ScriptGetByID(scripts[0].ScriptID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if script.ExecuteOn != zapi.ExecuteOnAgent || script.RawExecuteOn != nil || script.Command != "uptime" {
+		t.Errorf("Bad script: %#v", script)
+	}
+
+	script.ExecuteOn = zapi.ExecuteOnServer
+	err = api.ScriptsUpdate(zapi.Scripts{*script})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	script, err = api.ScriptGetByID(scripts[0].ScriptID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if script.ExecuteOn != zapi.ExecuteOnServer {
+		t.Errorf("Script is not updated: %#v", script)
+	}
+}
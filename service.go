@@ -0,0 +1,242 @@
+package zabbix
+
+type (
+	// ServiceAlgorithm how the service status is calculated from its children
+	// see "algorithm" in https://www.zabbix.com/documentation/current/en/manual/api/reference/service/object
+	ServiceAlgorithm int
+
+	// ServicePropagationRule how the service status is propagated to the parents
+	ServicePropagationRule int
+
+	// ServiceStatusRuleType condition of an additional status rule
+	ServiceStatusRuleType int
+
+	// TagOperator how a problem tag value is compared
+	TagOperator int
+)
+
+const (
+	// ServiceStatusOK always OK
+	ServiceStatusOK ServiceAlgorithm = 0
+	// ServiceMostCriticalIfAll most critical if all children have problems
+	ServiceMostCriticalIfAll ServiceAlgorithm = 1
+	// ServiceMostCriticalOfChildren most critical of child services
+	ServiceMostCriticalOfChildren ServiceAlgorithm = 2
+)
+
+const (
+	PropagateAsIs     ServicePropagationRule = 0
+	PropagateIncrease ServicePropagationRule = 1
+	PropagateDecrease ServicePropagationRule = 2
+	PropagateIgnore   ServicePropagationRule = 3
+	PropagateFixed    ServicePropagationRule = 4
+)
+
+const (
+	// see "type" in https://www.zabbix.com/documentation/current/en/manual/api/reference/service/object#status-rules
+
+	// StatusRuleChildrenCountAtLeast at least N child services have status or above
+	StatusRuleChildrenCountAtLeast ServiceStatusRuleType = 0
+	// StatusRuleChildrenPercentAtLeast at least N% of child services have status or above
+	StatusRuleChildrenPercentAtLeast ServiceStatusRuleType = 1
+	// StatusRuleChildrenCountBelow less than N child services have status or below
+	StatusRuleChildrenCountBelow ServiceStatusRuleType = 2
+	// StatusRuleChildrenPercentBelow less than N% of child services have status or below
+	StatusRuleChildrenPercentBelow ServiceStatusRuleType = 3
+	// StatusRuleWeightAtLeast weight of child services with status or above is at least W
+	StatusRuleWeightAtLeast ServiceStatusRuleType = 4
+	// StatusRuleWeightPercentAtLeast weight of child services with status or above is at least N%
+	StatusRuleWeightPercentAtLeast ServiceStatusRuleType = 5
+	// StatusRuleWeightBelow weight of child services with status or below is less than W
+	StatusRuleWeightBelow ServiceStatusRuleType = 6
+	// StatusRuleWeightPercentBelow weight of child services with status or below is less than N%
+	StatusRuleWeightPercentBelow ServiceStatusRuleType = 7
+)
+
+const (
+	// TagEquals tag value equals (default)
+	TagEquals TagOperator = 0
+	// TagLike tag value contains
+	TagLike TagOperator = 2
+)
+
+// ServiceID represent Zabbix ServiceID, used for parents and children
+type ServiceID struct {
+	ServiceID string `json:"serviceid"`
+}
+
+// ServiceIDs is an array of ServiceID
+type ServiceIDs []ServiceID
+
+// ProblemTag matches problems by tag
+type ProblemTag struct {
+	Tag      string      `json:"tag"`
+	Operator TagOperator `json:"operator,string"`
+	Value    string      `json:"value,omitempty"`
+}
+
+// ProblemTags is an array of ProblemTag
+type ProblemTags []ProblemTag
+
+// ServiceStatusRule represents additional rule of the service status calculation
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/service/object#status-rules
+type ServiceStatusRule struct {
+	Type        ServiceStatusRuleType `json:"type,string"`
+	LimitValue  int                   `json:"limit_value,string"`
+	LimitStatus SeverityType          `json:"limit_status,string"`
+	NewStatus   SeverityType          `json:"new_status,string"`
+}
+
+// ServiceStatusRules is an array of ServiceStatusRule
+type ServiceStatusRules []ServiceStatusRule
+
+// Service represent Zabbix service object, available since 6.0
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/service/object
+type Service struct {
+	ServiceID        string                 `json:"serviceid,omitempty"`
+	Name             string                 `json:"name"`
+	Algorithm        ServiceAlgorithm       `json:"algorithm,string"`
+	SortOrder        int                    `json:"sortorder,string"`
+	Weight           int                    `json:"weight,string"`
+	PropagationRule  ServicePropagationRule `json:"propagation_rule,string"`
+	PropagationValue int                    `json:"propagation_value,omitempty,string"`
+	Description      string                 `json:"description,omitempty"`
+
+	// read only, -1 for OK or severity of the problem
+	Status    int    `json:"status,omitempty,string"`
+	CreatedAt string `json:"created_at,omitempty"`
+	ReadOnly  bool   `json:"readonly,omitempty"`
+
+	// nil relations are not sent, empty ones remove all of them
+	Parents     ServiceIDs         `json:"parents,omitempty"`
+	Children    ServiceIDs         `json:"children,omitempty"`
+	Tags        Tags               `json:"tags,omitempty"`
+	ProblemTags ProblemTags        `json:"problem_tags,omitempty"`
+	StatusRules ServiceStatusRules `json:"status_rules,omitempty"`
+}
+
+// Services is an array of Service
+type Services []Service
+
+// prepServices converts the services to objects without read only fields,
+// parents and children are sent when set, empty ones as empty arrays.
+func prepServices(services Services) (res []Params, err error) {
+	res = make([]Params, len(services))
+	for i, service := range services {
+		service.Status = 0
+		service.CreatedAt = ""
+		service.ReadOnly = false
+		var params Params
+		if params, err = objectParams(service); err != nil {
+			return
+		}
+		if service.Parents != nil {
+			params["parents"] = service.Parents
+		}
+		if service.Children != nil {
+			params["children"] = service.Children
+		}
+		res[i] = params
+	}
+	return
+}
+
+// ServicesGet Wrapper for service.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/service/get
+func (api *API) ServicesGet(params Params) (res Services, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("service.get", params, &res)
+	return
+}
+
+// ServiceGetByID Gets service with its relations, tags and rules by Id only if there is exactly 1 matching service.
+func (api *API) ServiceGetByID(id string) (res *Service, err error) {
+	services, err := api.ServicesGet(Params{
+		"serviceids":        id,
+		"selectParents":     []string{"serviceid"},
+		"selectChildren":    []string{"serviceid"},
+		"selectTags":        "extend",
+		"selectProblemTags": "extend",
+		"selectStatusRules": "extend",
+	})
+	if err != nil {
+		return
+	}
+
+	if len(services) == 1 {
+		res = &services[0]
+	} else {
+		e := ExpectedOneResult(len(services))
+		err = &e
+	}
+	return
+}
+
+// ServicesCreate Wrapper for service.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/service/create
+func (api *API) ServicesCreate(services Services) (err error) {
+	create, err := prepServices(services)
+	if err != nil {
+		return
+	}
+	response, err := api.CallWithError("service.create", create)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	serviceids := result["serviceids"].([]interface{})
+	for i, id := range serviceids {
+		services[i].ServiceID = id.(string)
+	}
+	return
+}
+
+// ServicesUpdate Wrapper for service.update
+// Read only fields are not sent. Parents and children are replaced when not nil,
+// an empty slice removes all of them, nil keeps them.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/service/update
+func (api *API) ServicesUpdate(services Services) (err error) {
+	update, err := prepServices(services)
+	if err != nil {
+		return
+	}
+	_, err = api.CallWithError("service.update", update)
+	return
+}
+
+// ServicesDelete Wrapper for service.delete
+// Cleans ServiceID in all services elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/service/delete
+func (api *API) ServicesDelete(services Services) (err error) {
+	ids := make([]string, len(services))
+	for i, service := range services {
+		ids[i] = service.ServiceID
+	}
+
+	err = api.ServicesDeleteByIds(ids)
+	if err == nil {
+		for i := range services {
+			services[i].ServiceID = ""
+		}
+	}
+	return
+}
+
+// ServicesDeleteByIds Wrapper for service.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/service/delete
+func (api *API) ServicesDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("service.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	serviceids := result["serviceids"].([]interface{})
+	if len(ids) != len(serviceids) {
+		err = &ExpectedMore{len(ids), len(serviceids)}
+	}
+	return
+}
@@ -0,0 +1,81 @@
+package zabbix_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestServices(t *testing.T) {
+	api := getAPI(t)
+	if api.Config.Version < 60000 {
+		t.Skip("Services are available since 6.0")
+	}
+
+	parent := zapi.Service{Name: fmt.Sprintf("zabbix-testing-%d", rand.Int()), Algorithm: zapi.ServiceMostCriticalOfChildren}
+	services := zapi.Services{parent}
+	err := api.ServicesCreate(services)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer api.ServicesDelete(services)
+
+	children := zapi.Services{{
+		Name:             fmt.Sprintf("zabbix-testing-%d", rand.Int()),
+		Algorithm:        zapi.ServiceMostCriticalOfChildren,
+		Weight:           2,
+		PropagationRule:  zapi.PropagateIncrease,
+		PropagationValue: 1,
+		Parents:          zapi.ServiceIDs{{services[0].ServiceID}},
+	}}
+	err = api.ServicesCreate(children)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer api.ServicesDelete(children)
+
+	child, err := api.ServiceGetByID(children[0].ServiceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if child.Weight != 2 || child.PropagationRule != zapi.PropagateIncrease || len(child.Parents) != 1 {
+		t.Errorf("Bad service: %#v", child)
+	}
+
+	// relations are kept when not given
+	rename := *child
+	rename.Name += "-renamed"
+	rename.Parents = nil
+	rename.Children = nil
+	err = api.ServicesUpdate(zapi.Services{rename})
+	if err != nil {
+		t.Fatal(err)
+	}
+	renamed, err := api.ServiceGetByID(children[0].ServiceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renamed.Name != rename.Name || len(renamed.Parents) != 1 {
+		t.Errorf("Service relations are changed: %#v", renamed)
+	}
+
+	// zero values and empty parents reset the service
+	child.Weight = 0
+	child.PropagationRule = zapi.PropagateAsIs
+	child.PropagationValue = 0
+	child.Parents = zapi.ServiceIDs{}
+	err = api.ServicesUpdate(zapi.Services{*child})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	child, err = api.ServiceGetByID(children[0].ServiceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if child.Weight != 0 || child.PropagationRule != zapi.PropagateAsIs || len(child.Parents) != 0 {
+		t.Errorf("Service is not reset: %#v", child)
+	}
+}
@@ -0,0 +1,213 @@
+package zabbix
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// fieldVersion API versions supporting a field of a singleton object, zero until means still supported
+type fieldVersion struct {
+	since, until int
+	readOnly     bool
+}
+
+// objectParams converts the object to parameters, so that fields can be added, renamed or removed.
+func objectParams(object interface{}) (params Params, err error) {
+	asB, err := json.Marshal(object)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(asB, &params)
+	return
+}
+
+// jsonNames returns JSON names of the fields of the struct.
+func jsonNames(object interface{}) map[string]bool {
+	t := reflect.TypeOf(object)
+	res := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			res[name] = true
+		}
+	}
+	return res
+}
+
+// versionedParams converts the object to update parameters without read only fields
+// and fields unsupported by the server version. Fields missing in versions are always sent.
+// If fields are given, only these are sent, empty ones as "", and it is an error
+// to list a read only, unsupported or unknown field.
+func (api *API) versionedParams(object interface{}, versions map[string]fieldVersion, fields []string) (params Params, err error) {
+	if params, err = objectParams(object); err != nil {
+		return
+	}
+
+	requested := make(map[string]bool, len(fields))
+	if len(fields) != 0 {
+		known := jsonNames(object)
+		all := params
+		params = make(Params, len(fields))
+		for _, name := range fields {
+			if !known[name] {
+				return nil, fmt.Errorf("unknown field %q", name)
+			}
+			requested[name] = true
+			params[name] = ""
+			if v, present := all[name]; present {
+				params[name] = v
+			}
+		}
+	}
+
+	for name, v := range versions {
+		if v.readOnly || api.Config.Version < v.since || (v.until != 0 && api.Config.Version >= v.until) {
+			if requested[name] {
+				return nil, fmt.Errorf("field %q is read only or not supported by server version %d", name, api.Config.Version)
+			}
+			delete(params, name)
+		}
+	}
+	return
+}
+
+// Settings represent Zabbix global settings object, available since 5.2.
+// Empty fields are not updated unless listed in SettingsUpdate, flags are "0" disabled and "1" enabled.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/settings/object
+type Settings struct {
+	DefaultLang            string `json:"default_lang,omitempty"`
+	DefaultTimezone        string `json:"default_timezone,omitempty"`
+	DefaultTheme           string `json:"default_theme,omitempty"`
+	SearchLimit            string `json:"search_limit,omitempty"`
+	MaxOverviewTableSize   string `json:"max_overview_table_size,omitempty"`
+	MaxInTable             string `json:"max_in_table,omitempty"`
+	ServerCheckInterval    string `json:"server_check_interval,omitempty"`
+	WorkPeriod             string `json:"work_period,omitempty"`
+	ShowTechnicalErrors    string `json:"show_technical_errors,omitempty"`
+	HistoryPeriod          string `json:"history_period,omitempty"`
+	PeriodDefault          string `json:"period_default,omitempty"`
+	MaxPeriod              string `json:"max_period,omitempty"`
+	DiscoveryGroupID       string `json:"discovery_groupid,omitempty"`
+	DefaultInventoryMode   string `json:"default_inventory_mode,omitempty"`
+	AlertUserGroupID       string `json:"alert_usrgrpid,omitempty"`
+	SNMPTrapLogging        string `json:"snmptrap_logging,omitempty"`
+	LoginAttempts          string `json:"login_attempts,omitempty"`
+	LoginBlock             string `json:"login_block,omitempty"`
+	ValidateURISchemes     string `json:"validate_uri_schemes,omitempty"`
+	URIValidSchemes        string `json:"uri_valid_schemes,omitempty"`
+	XFrameOptions          string `json:"x_frame_options,omitempty"`
+	IframeSandboxing       string `json:"iframe_sandboxing_enabled,omitempty"`
+	IframeSandboxingExcept string `json:"iframe_sandboxing_exceptions,omitempty"`
+	ConnectTimeout         string `json:"connect_timeout,omitempty"`
+	SocketTimeout          string `json:"socket_timeout,omitempty"`
+	MediaTypeTestTimeout   string `json:"media_type_test_timeout,omitempty"`
+	ScriptTimeout          string `json:"script_timeout,omitempty"`
+	ItemTestTimeout        string `json:"item_test_timeout,omitempty"`
+
+	// problem display
+	SeverityColor0    string `json:"severity_color_0,omitempty"`
+	SeverityColor1    string `json:"severity_color_1,omitempty"`
+	SeverityColor2    string `json:"severity_color_2,omitempty"`
+	SeverityColor3    string `json:"severity_color_3,omitempty"`
+	SeverityColor4    string `json:"severity_color_4,omitempty"`
+	SeverityColor5    string `json:"severity_color_5,omitempty"`
+	SeverityName0     string `json:"severity_name_0,omitempty"`
+	SeverityName1     string `json:"severity_name_1,omitempty"`
+	SeverityName2     string `json:"severity_name_2,omitempty"`
+	SeverityName3     string `json:"severity_name_3,omitempty"`
+	SeverityName4     string `json:"severity_name_4,omitempty"`
+	SeverityName5     string `json:"severity_name_5,omitempty"`
+	CustomColor       string `json:"custom_color,omitempty"`
+	OkPeriod          string `json:"ok_period,omitempty"`
+	BlinkPeriod       string `json:"blink_period,omitempty"`
+	ProblemUnackColor string `json:"problem_unack_color,omitempty"`
+	ProblemAckColor   string `json:"problem_ack_color,omitempty"`
+	OkUnackColor      string `json:"ok_unack_color,omitempty"`
+	OkAckColor        string `json:"ok_ack_color,omitempty"`
+	ProblemUnackStyle string `json:"problem_unack_style,omitempty"`
+	ProblemAckStyle   string `json:"problem_ack_style,omitempty"`
+	OkUnackStyle      string `json:"ok_unack_style,omitempty"`
+	OkAckStyle        string `json:"ok_ack_style,omitempty"`
+
+	// since 5.4
+	URL               string `json:"url,omitempty"`
+	ReportTestTimeout string `json:"report_test_timeout,omitempty"`
+	AuditlogEnabled   string `json:"auditlog_enabled,omitempty"`
+
+	// since 6.0
+	HAFailoverDelay     string `json:"ha_failover_delay,omitempty"`
+	GeomapsTileProvider string `json:"geomaps_tile_provider,omitempty"`
+	GeomapsTileURL      string `json:"geomaps_tile_url,omitempty"`
+	GeomapsMaxZoom      string `json:"geomaps_max_zoom,omitempty"`
+	GeomapsAttribution  string `json:"geomaps_attribution,omitempty"`
+
+	// since 6.2
+	VaultProvider string `json:"vault_provider,omitempty"`
+
+	// since 7.0, default timeouts of item types
+	AuditlogMode         string `json:"auditlog_mode,omitempty"`
+	TimeoutZabbixAgent   string `json:"timeout_zabbix_agent,omitempty"`
+	TimeoutSimpleCheck   string `json:"timeout_simple_check,omitempty"`
+	TimeoutSNMPAgent     string `json:"timeout_snmp_agent,omitempty"`
+	TimeoutExternalCheck string `json:"timeout_external_check,omitempty"`
+	TimeoutDBMonitor     string `json:"timeout_db_monitor,omitempty"`
+	TimeoutHTTPAgent     string `json:"timeout_http_agent,omitempty"`
+	TimeoutSSHAgent      string `json:"timeout_ssh_agent,omitempty"`
+	TimeoutTelnetAgent   string `json:"timeout_telnet_agent,omitempty"`
+	TimeoutScript        string `json:"timeout_script,omitempty"`
+	TimeoutBrowser       string `json:"timeout_browser,omitempty"`
+
+	// read only
+	SessionKey      string `json:"session_key,omitempty"`
+	DBVersionStatus string `json:"dbversion_status,omitempty"`
+	ServerStatus    string `json:"server_status,omitempty"`
+}
+
+var settingsVersions = map[string]fieldVersion{
+	"url":                    {since: 50400},
+	"report_test_timeout":    {since: 50400},
+	"auditlog_enabled":       {since: 50400},
+	"ha_failover_delay":      {since: 60000},
+	"geomaps_tile_provider":  {since: 60000},
+	"geomaps_tile_url":       {since: 60000},
+	"geomaps_max_zoom":       {since: 60000},
+	"geomaps_attribution":    {since: 60000},
+	"vault_provider":         {since: 60200},
+	"auditlog_mode":          {since: 70000},
+	"timeout_zabbix_agent":   {since: 70000},
+	"timeout_simple_check":   {since: 70000},
+	"timeout_snmp_agent":     {since: 70000},
+	"timeout_external_check": {since: 70000},
+	"timeout_db_monitor":     {since: 70000},
+	"timeout_http_agent":     {since: 70000},
+	"timeout_ssh_agent":      {since: 70000},
+	"timeout_telnet_agent":   {since: 70000},
+	"timeout_script":         {since: 70000},
+	"timeout_browser":        {since: 70000},
+	"session_key":            {readOnly: true},
+	"dbversion_status":       {readOnly: true},
+	"server_status":          {readOnly: true},
+}
+
+// SettingsGet Wrapper for settings.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/settings/get
+func (api *API) SettingsGet() (res *Settings, err error) {
+	res = &Settings{}
+	err = api.CallWithErrorParse("settings.get", Params{"output": "extend"}, res)
+	return
+}
+
+// SettingsUpdate Wrapper for settings.update
+// Empty fields, read only fields and fields unsupported by the server version are not sent.
+// If JSON names of fields are given, only these fields are sent, so that they can be set to "".
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/settings/update
+func (api *API) SettingsUpdate(settings Settings, fields ...string) (err error) {
+	params, err := api.versionedParams(settings, settingsVersions, fields)
+	if err != nil {
+		return
+	}
+	_, err = api.CallWithError("settings.update", params)
+	return
+}
@@ -0,0 +1,77 @@
+package zabbix_test
+
+import (
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestSettings(t *testing.T) {
+	api := getAPI(t)
+	if api.Config.Version < 50200 {
+		t.Skip("settings API is available since 5.2")
+	}
+
+	settings, err := api.SettingsGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings.SearchLimit == "" {
+		t.Errorf("Settings are empty: %#v", settings)
+	}
+	err = api.SettingsUpdate(zapi.Settings{SearchLimit: settings.SearchLimit})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	housekeeping, err := api.HousekeepingGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = api.HousekeepingUpdate(*housekeeping)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authentication, err := api.AuthenticationGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authentication.AuthenticationType == "" {
+		t.Errorf("Authentication is empty: %#v", authentication)
+	}
+
+	_, err = api.AutoregistrationGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSettingsUpdateFields(t *testing.T) {
+	api := getAPI(t)
+	if api.Config.Version < 60000 {
+		t.Skip("geomaps settings are available since 6.0")
+	}
+
+	settings, err := api.SettingsGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// listed fields are sent even if empty
+	err = api.SettingsUpdate(zapi.Settings{GeomapsAttribution: settings.GeomapsAttribution}, "geomaps_attribution")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	old := &zapi.API{Config: zapi.Config{Version: 50200}}
+	if err = old.SettingsUpdate(zapi.Settings{}, "geomaps_attribution"); err == nil {
+		t.Error("Expected error for field unsupported by the server version")
+	}
+	if err = api.SettingsUpdate(zapi.Settings{}, "session_key"); err == nil {
+		t.Error("Expected error for read only field")
+	}
+	if err = api.HousekeepingUpdate(zapi.Housekeeping{}, "hk_unknown"); err == nil {
+		t.Error("Expected error for unknown field")
+	}
+}
@@ -0,0 +1,208 @@
+package zabbix
+
+import "strconv"
+
+type (
+	// SLAPeriod reporting period of the SLA
+	// see "period" in https://www.zabbix.com/documentation/current/en/manual/api/reference/sla/object
+	SLAPeriod int
+
+	// SLAStatus whether the SLA is enabled
+	SLAStatus int
+)
+
+const (
+	SLADaily     SLAPeriod = 0
+	SLAWeekly    SLAPeriod = 1
+	SLAMonthly   SLAPeriod = 2
+	SLAQuarterly SLAPeriod = 3
+	SLAAnnually  SLAPeriod = 4
+)
+
+const (
+	SLADisabled SLAStatus = 0
+	SLAEnabled  SLAStatus = 1
+)
+
+// SLASchedule represents a weekly connected period of the SLA schedule,
+// in seconds since Sunday 00:00
+type SLASchedule struct {
+	PeriodFrom int `json:"period_from,string"`
+	PeriodTo   int `json:"period_to,string"`
+}
+
+// SLAExcludedDowntime represents a downtime excluded from the SLA calculation
+type SLAExcludedDowntime struct {
+	Name       string `json:"name"`
+	PeriodFrom int64  `json:"period_from,string"`
+	PeriodTo   int64  `json:"period_to,string"`
+}
+
+// SLA represent Zabbix SLA object, available since 6.0
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/sla/object
+type SLA struct {
+	SLAID         string    `json:"slaid,omitempty"`
+	Name          string    `json:"name"`
+	Period        SLAPeriod `json:"period,string"`
+	SLO           string    `json:"slo"`
+	EffectiveDate int64     `json:"effective_date,omitempty,string"`
+	Timezone      string    `json:"timezone,omitempty"`
+	Status        SLAStatus `json:"status,string"`
+	Description   string    `json:"description,omitempty"`
+
+	ServiceTags       ProblemTags           `json:"service_tags"`
+	Schedule          []SLASchedule         `json:"schedule,omitempty"`
+	ExcludedDowntimes []SLAExcludedDowntime `json:"excluded_downtimes,omitempty"`
+}
+
+// SLAs is an array of SLA
+type SLAs []SLA
+
+// SLIPeriod represents a reporting period returned by sla.getsli
+type SLIPeriod struct {
+	PeriodFrom int64 `json:"period_from"`
+	PeriodTo   int64 `json:"period_to"`
+}
+
+// SLIExcludedDowntime represents excluded downtime within a reporting period
+type SLIExcludedDowntime struct {
+	Name       string `json:"name"`
+	PeriodFrom int64  `json:"period_from"`
+	PeriodTo   int64  `json:"period_to"`
+}
+
+// SLIValue represents service availability within a reporting period
+type SLIValue struct {
+	Uptime            int64                 `json:"uptime"`
+	Downtime          int64                 `json:"downtime"`
+	SLI               float64               `json:"sli"`
+	ErrorBudget       int64                 `json:"error_budget"`
+	ExcludedDowntimes []SLIExcludedDowntime `json:"excluded_downtimes"`
+}
+
+// SLIReport is the result of sla.getsli, SLI is indexed by period and then by service
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/sla/getsli
+type SLIReport struct {
+	Periods    []SLIPeriod  `json:"periods"`
+	ServiceIDs []int64      `json:"serviceids"`
+	SLI        [][]SLIValue `json:"sli"`
+}
+
+// SLIResult is the SLI of a single service within a single period
+type SLIResult struct {
+	ServiceID string
+	Period    SLIPeriod
+	SLIValue
+}
+
+// Results flattens the report to one result per period and service.
+func (r SLIReport) Results() (res []SLIResult) {
+	for i, period := range r.Periods {
+		if i >= len(r.SLI) {
+			break
+		}
+		for j, id := range r.ServiceIDs {
+			if j >= len(r.SLI[i]) {
+				break
+			}
+			res = append(res, SLIResult{strconv.FormatInt(id, 10), period, r.SLI[i][j]})
+		}
+	}
+	return
+}
+
+// SLAsGet Wrapper for sla.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/sla/get
+func (api *API) SLAsGet(params Params) (res SLAs, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("sla.get", params, &res)
+	return
+}
+
+// SLAGetByID Gets SLA with service tags, schedule and excluded downtimes by Id only if there is exactly 1 matching SLA.
+func (api *API) SLAGetByID(id string) (res *SLA, err error) {
+	slas, err := api.SLAsGet(Params{
+		"slaids":                  id,
+		"selectServiceTags":       "extend",
+		"selectSchedule":          "extend",
+		"selectExcludedDowntimes": "extend",
+	})
+	if err != nil {
+		return
+	}
+
+	if len(slas) == 1 {
+		res = &slas[0]
+	} else {
+		e := ExpectedOneResult(len(slas))
+		err = &e
+	}
+	return
+}
+
+// SLAGetSLI Wrapper for sla.getsli
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/sla/getsli
+func (api *API) SLAGetSLI(params Params) (res *SLIReport, err error) {
+	res = &SLIReport{}
+	err = api.CallWithErrorParse("sla.getsli", params, res)
+	return
+}
+
+// SLAsCreate Wrapper for sla.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/sla/create
+func (api *API) SLAsCreate(slas SLAs) (err error) {
+	response, err := api.CallWithError("sla.create", slas)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	slaids := result["slaids"].([]interface{})
+	for i, id := range slaids {
+		slas[i].SLAID = id.(string)
+	}
+	return
+}
+
+// SLAsUpdate Wrapper for sla.update
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/sla/update
+func (api *API) SLAsUpdate(slas SLAs) (err error) {
+	_, err = api.CallWithError("sla.update", slas)
+	return
+}
+
+// SLAsDelete Wrapper for sla.delete
+// Cleans SLAID in all slas elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/sla/delete
+func (api *API) SLAsDelete(slas SLAs) (err error) {
+	ids := make([]string, len(slas))
+	for i, sla := range slas {
+		ids[i] = sla.SLAID
+	}
+
+	err = api.SLAsDeleteByIds(ids)
+	if err == nil {
+		for i := range slas {
+			slas[i].SLAID = ""
+		}
+	}
+	return
+}
+
+// SLAsDeleteByIds Wrapper for sla.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/sla/delete
+func (api *API) SLAsDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("sla.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	slaids := result["slaids"].([]interface{})
+	if len(ids) != len(slaids) {
+		err = &ExpectedMore{len(ids), len(slaids)}
+	}
+	return
+}
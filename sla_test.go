@@ -0,0 +1,47 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+const sliReportJSON = `{
+	"periods": [
+		{"period_from": 1633046400, "period_to": 1635724800},
+		{"period_from": 1635724800, "period_to": 1638316800}
+	],
+	"serviceids": [1, 2],
+	"sli": [
+		[
+			{"uptime": 1186212, "downtime": 0, "sli": 100, "error_budget": 0, "excluded_downtimes": []},
+			{"uptime": 1186000, "downtime": 212, "sli": 99.98, "error_budget": 100, "excluded_downtimes": [
+				{"name": "Maintenance", "period_from": 1633305600, "period_to": 1633651200}
+			]}
+		],
+		[
+			{"uptime": 0, "downtime": 0, "sli": 100, "error_budget": 0, "excluded_downtimes": []},
+			{"uptime": 0, "downtime": 0, "sli": 100, "error_budget": 0, "excluded_downtimes": []}
+		]
+	]
+}`
+
+func TestSLIReportResults(t *testing.T) {
+	var report zapi.SLIReport
+	if err := json.Unmarshal([]byte(sliReportJSON), &report); err != nil {
+		t.Fatal(err)
+	}
+
+	results := report.Results()
+	if len(results) != 4 {
+		t.Fatalf("Expected 4 results, got %#v", results)
+	}
+	r := results[1]
+	if r.ServiceID != "2" || r.Period.PeriodFrom != 1633046400 || r.SLI != 99.98 || len(r.ExcludedDowntimes) != 1 {
+		t.Errorf("Bad result: %#v", r)
+	}
+	if results[2].ServiceID != "1" || results[2].Period.PeriodFrom != 1635724800 {
+		t.Errorf("Bad result: %#v", results[2])
+	}
+}
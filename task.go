@@ -0,0 +1,241 @@
+package zabbix
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type (
+	// TaskType type of the task
+	// see "type" in https://www.zabbix.com/documentation/current/en/manual/api/reference/task/object
+	TaskType int
+
+	// TaskStatus status of the task
+	TaskStatus int
+)
+
+const (
+	TaskDiagnosticInfo TaskType = 1
+	TaskCheckNow       TaskType = 6
+)
+
+const (
+	TaskNew        TaskStatus = 1
+	TaskInProgress TaskStatus = 2
+	TaskCompleted  TaskStatus = 3
+	TaskExpired    TaskStatus = 4
+)
+
+// DiagnosticRequestSection requested statistics of a server or proxy component
+type DiagnosticRequestSection struct {
+	// Stats names of the statistics, all of them when empty
+	Stats []string `json:"-"`
+	// Top number of top entries by statistic, e.g. {"values": 10}
+	Top map[string]int `json:"top,omitempty"`
+}
+
+// MarshalJSON requests all statistics when none are listed.
+func (s DiagnosticRequestSection) MarshalJSON() ([]byte, error) {
+	var stats interface{} = "extend"
+	if len(s.Stats) != 0 {
+		stats = s.Stats
+	}
+	return json.Marshal(struct {
+		Stats interface{}    `json:"stats"`
+		Top   map[string]int `json:"top,omitempty"`
+	}{stats, s.Top})
+}
+
+// DiagnosticInfoRequest components to collect diagnostic information about, nil ones are skipped
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/task/object#diagnostic-information-request-object
+type DiagnosticInfoRequest struct {
+	HistoryCache  *DiagnosticRequestSection `json:"historycache,omitempty"`
+	ValueCache    *DiagnosticRequestSection `json:"valuecache,omitempty"`
+	Preprocessing *DiagnosticRequestSection `json:"preprocessing,omitempty"`
+	Alerting      *DiagnosticRequestSection `json:"alerting,omitempty"`
+	LLD           *DiagnosticRequestSection `json:"lld,omitempty"`
+}
+
+// DiagnosticSection statistics of a server or proxy component
+type DiagnosticSection struct {
+	// Time spent collecting the statistics, in seconds
+	Time float64
+	// Stats statistics of the component, e.g. "items" and "memory" of the history cache
+	Stats map[string]json.RawMessage
+	// Top top entries by statistic
+	Top map[string]json.RawMessage
+}
+
+// UnmarshalJSON splits time and top entries from other statistics.
+func (s *DiagnosticSection) UnmarshalJSON(data []byte) (err error) {
+	if err = json.Unmarshal(data, &s.Stats); err != nil {
+		return
+	}
+	if raw, present := s.Stats["time"]; present {
+		if err = json.Unmarshal(raw, &s.Time); err != nil {
+			return
+		}
+		delete(s.Stats, "time")
+	}
+	if raw, present := s.Stats["top"]; present {
+		if err = json.Unmarshal(raw, &s.Top); err != nil {
+			return
+		}
+		delete(s.Stats, "top")
+	}
+	return
+}
+
+// DiagnosticInfo diagnostic information returned by a completed task, nil sections were not requested
+type DiagnosticInfo struct {
+	HistoryCache  *DiagnosticSection `json:"historycache,omitempty"`
+	ValueCache    *DiagnosticSection `json:"valuecache,omitempty"`
+	Preprocessing *DiagnosticSection `json:"preprocessing,omitempty"`
+	Alerting      *DiagnosticSection `json:"alerting,omitempty"`
+	LLD           *DiagnosticSection `json:"lld,omitempty"`
+}
+
+// TaskResult result of a completed task, status "0" success and "-1" failure
+type TaskResult struct {
+	Data   json.RawMessage `json:"data"`
+	Status string          `json:"status"`
+}
+
+// DiagnosticInfo parses data of a diagnostic information task.
+func (r TaskResult) DiagnosticInfo() (res *DiagnosticInfo, err error) {
+	res = &DiagnosticInfo{}
+	err = json.Unmarshal(r.Data, res)
+	return
+}
+
+// Task represent Zabbix task object, task.get is available since 5.4
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/task/object
+type Task struct {
+	TaskID  string          `json:"taskid"`
+	Type    TaskType        `json:"type,string"`
+	Status  TaskStatus      `json:"status,string"`
+	Clock   int64           `json:"clock,string"`
+	TTL     int64           `json:"ttl,string"`
+	ProxyID string          `json:"proxy_hostid,omitempty"`
+	Request json.RawMessage `json:"request,omitempty"`
+	Result  *TaskResult     `json:"result,omitempty"`
+
+	// since 7.0 ProxyID is read back from this one
+	RawProxyID string `json:"proxyid,omitempty"`
+}
+
+// Tasks is an array of Task
+type Tasks []Task
+
+// TasksGet Wrapper for task.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/task/get
+func (api *API) TasksGet(params Params) (res Tasks, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("task.get", params, &res)
+	for i := range res {
+		if res[i].RawProxyID != "" {
+			res[i].ProxyID = res[i].RawProxyID
+			res[i].RawProxyID = ""
+		}
+	}
+	return
+}
+
+// TaskGetByID Gets task by Id only if there is exactly 1 matching task.
+func (api *API) TaskGetByID(id string) (res *Task, err error) {
+	tasks, err := api.TasksGet(Params{"taskids": id})
+	if err != nil {
+		return
+	}
+
+	if len(tasks) == 1 {
+		res = &tasks[0]
+	} else {
+		e := ExpectedOneResult(len(tasks))
+		err = &e
+	}
+	return
+}
+
+// tasksCreate Wrapper for task.create, returns IDs of created tasks.
+func (api *API) tasksCreate(params interface{}) (res []string, err error) {
+	response, err := api.CallWithError("task.create", params)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	taskids := result["taskids"].([]interface{})
+	for _, id := range taskids {
+		res = append(res, id.(string))
+	}
+	return
+}
+
+// checkNow creates "check now" tasks for the items or LLD rules, a single task before 5.4.
+func (api *API) checkNow(ids []string) (res []string, err error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("nothing to check")
+	}
+	if api.Config.Version < 50400 {
+		return api.tasksCreate(Params{"type": TaskCheckNow, "itemids": ids})
+	}
+
+	tasks := make([]Params, len(ids))
+	for i, id := range ids {
+		tasks[i] = Params{"type": TaskCheckNow, "request": Params{"itemid": id}}
+	}
+	return api.tasksCreate(tasks)
+}
+
+// ItemsCheckNow Wrapper for task.create
+// Requests the items to be checked as soon as possible, returns IDs of created tasks.
+// The API is not called when no items are given, an error is returned instead.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/task/create
+func (api *API) ItemsCheckNow(items Items) (res []string, err error) {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ItemID
+	}
+	return api.checkNow(ids)
+}
+
+// LLDsCheckNow Wrapper for task.create
+// Requests the LLD rules to be checked as soon as possible, returns IDs of created tasks.
+// The API is not called when no rules are given, an error is returned instead.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/task/create
+func (api *API) LLDsCheckNow(rules LLDRules) (res []string, err error) {
+	ids := make([]string, len(rules))
+	for i, rule := range rules {
+		ids[i] = rule.ItemID
+	}
+	return api.checkNow(ids)
+}
+
+// DiagnosticInfoRequestCreate Wrapper for task.create, available since 5.4
+// Requests diagnostic information from the server, or the proxy if proxyID is not empty.
+// Returns ID of the task, its result is read with TaskGetByID once completed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/task/create
+func (api *API) DiagnosticInfoRequestCreate(request DiagnosticInfoRequest, proxyID string) (res string, err error) {
+	task := Params{"type": TaskDiagnosticInfo, "request": request}
+	if proxyID != "" {
+		if api.Config.Version >= 70000 {
+			task["proxyid"] = proxyID
+		} else {
+			task["proxy_hostid"] = proxyID
+		}
+	}
+
+	ids, err := api.tasksCreate([]Params{task})
+	if err != nil {
+		return
+	}
+	if len(ids) != 1 {
+		e := ExpectedOneResult(len(ids))
+		err = &e
+		return
+	}
+	return ids[0], nil
+}
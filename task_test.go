@@ -0,0 +1,172 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestDiagnosticInfo(t *testing.T) {
+	request := zapi.DiagnosticInfoRequest{
+		HistoryCache: &zapi.DiagnosticRequestSection{Top: map[string]int{"values": 5}},
+		ValueCache:   &zapi.DiagnosticRequestSection{Stats: []string{"items"}},
+	}
+	asB, err := json.Marshal(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"historycache":{"stats":"extend","top":{"values":5}},"valuecache":{"stats":["items"]}}`
+	if string(asB) != expected {
+		t.Errorf("Bad request: %s", asB)
+	}
+
+	result := zapi.TaskResult{
+		Status: "0",
+		Data:   json.RawMessage(`{"historycache":{"items":3,"values":7,"time":0.000022,"top":{"values":[{"itemid":23662,"values":2}]}}}`),
+	}
+	info, err := result.DiagnosticInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hc := info.HistoryCache
+	if hc == nil || hc.Time != 0.000022 || string(hc.Stats["values"]) != "7" || len(hc.Top["values"]) == 0 || info.ValueCache != nil {
+		t.Errorf("Bad diagnostic info: %#v", info)
+	}
+	if _, present := hc.Stats["time"]; present {
+		t.Errorf("Time is left in stats: %#v", hc.Stats)
+	}
+}
+
+// checkNowServer fakes a server of the version, recording params of task.create calls.
+func checkNowServer(version string, created *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+			ID     int32           `json:"id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var result interface{} = version
+		if req.Method == "task.create" {
+			*created = append(*created, string(req.Params))
+			result = map[string]interface{}{"taskids": []string{"1"}}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "result": result, "id": req.ID})
+	}))
+}
+
+func TestCheckNowRequest(t *testing.T) {
+	items := zapi.Items{{ItemID: "1"}, {ItemID: "2"}}
+	for version, expected := range map[string]string{
+		"5.2.0": `{"itemids":["1","2"],"type":6}`,
+		"5.4.0": `[{"request":{"itemid":"1"},"type":6},{"request":{"itemid":"2"},"type":6}]`,
+	} {
+		var created []string
+		srv := checkNowServer(version, &created)
+		api, err := zapi.NewAPI(zapi.Config{Url: srv.URL})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = api.ItemsCheckNow(items)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = api.ItemsCheckNow(nil)
+		if err == nil {
+			t.Errorf("%s: expected error for no items", version)
+		}
+		if len(created) != 1 || created[0] != expected {
+			t.Errorf("%s: bad requests: %v", version, created)
+		}
+		srv.Close()
+	}
+}
+
+func TestTasks(t *testing.T) {
+	api := getAPI(t)
+	if api.Config.Version < 50400 {
+		t.Skip("task.get is available since 5.4")
+	}
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	host := CreateHost(group, t)
+	defer DeleteHost(host, t)
+
+	interfaces, err := api.HostInterfacesGetByHost(*host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(interfaces) != 1 {
+		t.Fatalf("Bad interfaces: %#v", interfaces)
+	}
+
+	// trapper items can't be checked, agent ones are polled through the host interface
+	items := zapi.Items{{
+		HostID:      host.HostID,
+		InterfaceID: interfaces[0].InterfaceID,
+		Key:         "agent.ping",
+		Name:        "name for agent.ping",
+		Type:        zapi.ZabbixAgent,
+		ValueType:   zapi.Unsigned,
+		Delay:       "1h",
+	}}
+	err = api.ItemsCreate(items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer DeleteItem(&items[0], t)
+
+	ids, err := api.ItemsCheckNow(items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 {
+		t.Fatalf("Expected 1 task, got %v", ids)
+	}
+	task, err := api.TaskGetByID(ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.Type != zapi.TaskCheckNow {
+		t.Errorf("Bad task: %#v", task)
+	}
+
+	rule := CreateLLDRule(host, t)
+	defer DeleteLLDRule(rule, t)
+	rule.Type = zapi.ZabbixAgent
+	rule.InterfaceID = interfaces[0].InterfaceID
+	rule.Key = "vfs.fs.discovery"
+	rule.Delay = "1h"
+	err = api.LLDsUpdate(zapi.LLDRules{*rule})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err = api.LLDsCheckNow(zapi.LLDRules{*rule})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 {
+		t.Errorf("Expected 1 task, got %v", ids)
+	}
+
+	id, err := api.DiagnosticInfoRequestCreate(zapi.DiagnosticInfoRequest{HistoryCache: &zapi.DiagnosticRequestSection{}}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	task, err = api.TaskGetByID(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.Type != zapi.TaskDiagnosticInfo {
+		t.Errorf("Bad task: %#v", task)
+	}
+}
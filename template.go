@@ -3,16 +3,19 @@ package zabbix
 // Template represent Zabbix Template type returned from Zabbix API
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/template/object
 type Template struct {
-	TemplateID      string       `json:"templateid,omitempty"`
-	Host            string       `json:"host"`
-	Description     string       `json:"description,omitempty"`
-	Name            string       `json:"name,omitempty"`
-	Groups          HostGroupIDs `json:"groups"`
-	UserMacros      Macros       `json:"macros"`
-	LinkedTemplates TemplateIDs  `json:"templates,omitempty"`
-	ParentTemplates TemplateIDs  `json:"parentTemplates,omitempty"`
-	TemplatesClear  TemplateIDs  `json:"templates_clear,omitempty"`
-	LinkedHosts     []string     `json:"hosts,omitempty"`
+	TemplateID  string `json:"templateid,omitempty"`
+	Host        string `json:"host"`
+	Description string `json:"description,omitempty"`
+	Name        string `json:"name,omitempty"`
+	// Groups are host groups before 6.2 and template groups since 6.2
+	Groups HostGroupIDs `json:"groups"`
+	// template groups are read back from this one since 6.2
+	RawTemplateGroups HostGroupIDs `json:"templategroups,omitempty"`
+	UserMacros        Macros       `json:"macros"`
+	LinkedTemplates   TemplateIDs  `json:"templates,omitempty"`
+	ParentTemplates   TemplateIDs  `json:"parentTemplates,omitempty"`
+	TemplatesClear    TemplateIDs  `json:"templates_clear,omitempty"`
+	LinkedHosts       []string     `json:"hosts,omitempty"`
 }
 
 // Templates is an Array of Template structs.
@@ -32,13 +35,24 @@ func (api *API) TemplatesGet(params Params) (res Templates, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
+	// since 6.2 groups of templates are template groups
+	if selectGroups, present := params["selectGroups"]; present && api.Config.Version >= 60200 {
+		delete(params, "selectGroups")
+		params["selectTemplateGroups"] = selectGroups
+	}
 	err = api.CallWithErrorParse("template.get", params, &res)
+	for i := range res {
+		if res[i].RawTemplateGroups != nil {
+			res[i].Groups = res[i].RawTemplateGroups
+			res[i].RawTemplateGroups = nil
+		}
+	}
 	return
 }
 
 // TemplateGetByID Gets template by Id only if there is exactly 1 matching template.
 func (api *API) TemplateGetByID(id string) (template *Template, err error) {
-	templates, err := api.TemplatesGet(Params{"templateids": id})
+	templates, err := api.TemplatesGet(Params{"templateids": id, "selectGroups": []string{"groupid"}})
 	if err != nil {
 		return
 	}
@@ -55,7 +69,7 @@ func (api *API) TemplateGetByID(id string) (template *Template, err error) {
 // TemplatesCreate Wrapper for template.create
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/template/create
 func (api *API) TemplatesCreate(templates Templates) (err error) {
-	response, err := api.CallWithError("template.create", templates)
+	response, err := api.CallWithError("template.create", api.prepTemplates(templates))
 	if err != nil {
 		return
 	}
@@ -71,10 +85,20 @@ func (api *API) TemplatesCreate(templates Templates) (err error) {
 // TemplatesUpdate Wrapper for template.update
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/template/update
 func (api *API) TemplatesUpdate(templates Templates) (err error) {
-	_, err = api.CallWithError("template.update", templates)
+	_, err = api.CallWithError("template.update", api.prepTemplates(templates))
 	return
 }
 
+// prepTemplates returns copies of the templates with macros of the server version
+func (api *API) prepTemplates(templates Templates) Templates {
+	res := make(Templates, len(templates))
+	for i, template := range templates {
+		template.UserMacros = api.prepMacros(template.UserMacros)
+		res[i] = template
+	}
+	return res
+}
+
 // TemplatesDelete Wrapper for template.delete
 // Cleans ApplicationID in all apps elements if call succeed.
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/template/delete
@@ -109,3 +133,63 @@ func (api *API) TemplatesDeleteByIds(ids []string) (err error) {
 	}
 	return
 }
+
+func templateIDs(templates Templates) TemplateIDs {
+	ids := make(TemplateIDs, len(templates))
+	for i, template := range templates {
+		ids[i] = TemplateID{template.TemplateID}
+	}
+	return ids
+}
+
+// values returns plain IDs, as taken by massremove methods
+func (ids TemplateIDs) values() []string {
+	res := make([]string, len(ids))
+	for i, id := range ids {
+		res[i] = id.TemplateID
+	}
+	return res
+}
+
+// TemplateMassOptions objects added to templates by template.massadd
+// or removed from templates by template.massremove, macros are removed by name
+type TemplateMassOptions struct {
+	Groups          HostGroupIDs `json:"groups,omitempty"`
+	Macros          Macros       `json:"macros,omitempty"`
+	LinkedTemplates TemplateIDs  `json:"templates_link,omitempty"`
+	// TemplatesClear templates to unlink and clear, template.massremove only
+	TemplatesClear TemplateIDs `json:"-"`
+}
+
+// TemplatesMassAdd Wrapper for template.massadd
+// Adds groups, macros and linked templates to the templates, keeping existing ones.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/template/massadd
+func (api *API) TemplatesMassAdd(templates Templates, options TemplateMassOptions) (err error) {
+	options.Macros = api.prepMacros(options.Macros)
+	_, err = api.CallWithError("template.massadd", struct {
+		Templates TemplateIDs `json:"templates"`
+		TemplateMassOptions
+	}{templateIDs(templates), options})
+	return
+}
+
+// TemplatesMassRemove Wrapper for template.massremove
+// Removes groups, macros and linked templates from the templates.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/template/massremove
+func (api *API) TemplatesMassRemove(templates Templates, options TemplateMassOptions) (err error) {
+	params := Params{"templateids": templateIDs(templates).values()}
+	if len(options.Groups) != 0 {
+		params["groupids"] = options.Groups.values()
+	}
+	if len(options.Macros) != 0 {
+		params["macros"] = options.Macros.names()
+	}
+	if len(options.LinkedTemplates) != 0 {
+		params["templateids_link"] = options.LinkedTemplates.values()
+	}
+	if len(options.TemplatesClear) != 0 {
+		params["templateids_clear"] = options.TemplatesClear.values()
+	}
+	_, err = api.CallWithError("template.massremove", params)
+	return
+}
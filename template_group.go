@@ -0,0 +1,143 @@
+package zabbix
+
+// TemplateGroup represent Zabbix template group object, available since 6.2.
+// Before 6.2 templates belong to host groups.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templategroup/object
+type TemplateGroup struct {
+	GroupID string `json:"groupid,omitempty"`
+	Name    string `json:"name"`
+	UUID    string `json:"uuid,omitempty"`
+}
+
+// TemplateGroups is an array of TemplateGroup
+type TemplateGroups []TemplateGroup
+
+// templateGroupIDs converts template groups to the group references used by mass operations
+func templateGroupIDs(groups TemplateGroups) HostGroupIDs {
+	ids := make(HostGroupIDs, len(groups))
+	for i, group := range groups {
+		ids[i] = HostGroupID{group.GroupID}
+	}
+	return ids
+}
+
+// TemplateGroupsGet Wrapper for templategroup.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templategroup/get
+func (api *API) TemplateGroupsGet(params Params) (res TemplateGroups, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("templategroup.get", params, &res)
+	return
+}
+
+// TemplateGroupGetByID Gets template group by Id only if there is exactly 1 matching template group.
+func (api *API) TemplateGroupGetByID(id string) (res *TemplateGroup, err error) {
+	groups, err := api.TemplateGroupsGet(Params{"groupids": id})
+	if err != nil {
+		return
+	}
+
+	if len(groups) == 1 {
+		res = &groups[0]
+	} else {
+		e := ExpectedOneResult(len(groups))
+		err = &e
+	}
+	return
+}
+
+// TemplateGroupsCreate Wrapper for templategroup.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templategroup/create
+func (api *API) TemplateGroupsCreate(groups TemplateGroups) (err error) {
+	response, err := api.CallWithError("templategroup.create", groups)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	groupids := result["groupids"].([]interface{})
+	for i, id := range groupids {
+		groups[i].GroupID = id.(string)
+	}
+	return
+}
+
+// TemplateGroupsUpdate Wrapper for templategroup.update
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templategroup/update
+func (api *API) TemplateGroupsUpdate(groups TemplateGroups) (err error) {
+	_, err = api.CallWithError("templategroup.update", groups)
+	return
+}
+
+// TemplateGroupsDelete Wrapper for templategroup.delete
+// Cleans GroupID in all groups elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templategroup/delete
+func (api *API) TemplateGroupsDelete(groups TemplateGroups) (err error) {
+	ids := make([]string, len(groups))
+	for i, group := range groups {
+		ids[i] = group.GroupID
+	}
+
+	err = api.TemplateGroupsDeleteByIds(ids)
+	if err == nil {
+		for i := range groups {
+			groups[i].GroupID = ""
+		}
+	}
+	return
+}
+
+// TemplateGroupsDeleteByIds Wrapper for templategroup.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templategroup/delete
+func (api *API) TemplateGroupsDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("templategroup.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	groupids := result["groupids"].([]interface{})
+	if len(ids) != len(groupids) {
+		err = &ExpectedMore{len(ids), len(groupids)}
+	}
+	return
+}
+
+// TemplateGroupsMassAdd Wrapper for templategroup.massadd
+// Adds the templates to the groups.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templategroup/massadd
+func (api *API) TemplateGroupsMassAdd(groups TemplateGroups, templates TemplateIDs) (err error) {
+	_, err = api.CallWithError("templategroup.massadd", Params{
+		"groups":    templateGroupIDs(groups),
+		"templates": templates,
+	})
+	return
+}
+
+// TemplateGroupsMassRemove Wrapper for templategroup.massremove
+// Removes the templates from the groups.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templategroup/massremove
+func (api *API) TemplateGroupsMassRemove(groups TemplateGroups, templates TemplateIDs) (err error) {
+	groupids := make([]string, len(groups))
+	for i, group := range groups {
+		groupids[i] = group.GroupID
+	}
+
+	_, err = api.CallWithError("templategroup.massremove", Params{
+		"groupids":    groupids,
+		"templateids": templates.values(),
+	})
+	return
+}
+
+// TemplateGroupsPropagate Wrapper for templategroup.propagate
+// Copies permissions of the groups to their subgroups.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/templategroup/propagate
+func (api *API) TemplateGroupsPropagate(groups TemplateGroups) (err error) {
+	_, err = api.CallWithError("templategroup.propagate", Params{
+		"groups":      templateGroupIDs(groups),
+		"permissions": true,
+	})
+	return
+}
@@ -0,0 +1,72 @@
+package zabbix_test
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func CreateTemplateGroup(t *testing.T) *zapi.TemplateGroup {
+	groups := zapi.TemplateGroups{{Name: fmt.Sprintf("zabbix-testing-%d", rand.Int())}}
+	err := getAPI(t).TemplateGroupsCreate(groups)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &groups[0]
+}
+
+func DeleteTemplateGroup(group *zapi.TemplateGroup, t *testing.T) {
+	err := getAPI(t).TemplateGroupsDelete(zapi.TemplateGroups{*group})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTemplateGroups(t *testing.T) {
+	api := getAPI(t)
+	if api.Config.Version < 60200 {
+		t.Skip("Template groups are available since 6.2")
+	}
+
+	group := CreateTemplateGroup(t)
+	defer DeleteTemplateGroup(group, t)
+	if group.GroupID == "" || group.Name == "" {
+		t.Errorf("Something is empty: %#v", group)
+	}
+
+	group2, err := api.TemplateGroupGetByID(group.GroupID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	group2.UUID = ""
+	if !reflect.DeepEqual(group, group2) {
+		t.Errorf("Error getting group.\nOld group: %#v\nNew group: %#v", group, group2)
+	}
+
+	group3 := CreateTemplateGroup(t)
+	defer DeleteTemplateGroup(group3, t)
+
+	template := CreateTemplate(group.GroupID, t)
+	defer DeleteTemplate(template, t)
+
+	templates := zapi.TemplateIDs{{TemplateID: template.TemplateID}}
+	err = api.TemplateGroupsMassAdd(zapi.TemplateGroups{*group3}, templates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template2, err := api.TemplateGetByID(template.TemplateID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(template2.Groups) != 2 {
+		t.Errorf("Expected 2 groups, got %#v", template2.Groups)
+	}
+
+	err = api.TemplateGroupsMassRemove(zapi.TemplateGroups{*group3}, templates)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
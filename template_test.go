@@ -6,10 +6,10 @@ import (
 	zapi "github.com/lavrenko/go-zabbix-api"
 )
 
-func CreateTemplate(hostGroup *zapi.HostGroup, t *testing.T) *zapi.Template {
+func CreateTemplate(groupID string, t *testing.T) *zapi.Template {
 
 	group := zapi.HostGroupID{
-		GroupID: hostGroup.GroupID,
+		GroupID: groupID,
 	}
 
 	groups := []zapi.HostGroupID{group}
@@ -35,10 +35,19 @@ func DeleteTemplate(template *zapi.Template, t *testing.T) {
 func TestTemplates(t *testing.T) {
 	api := getAPI(t)
 
-	hostGroup := CreateHostGroup(t)
-	defer DeleteHostGroup(hostGroup, t)
+	// since 6.2 templates belong to template groups
+	var groupID string
+	if api.Config.Version >= 60200 {
+		templateGroup := CreateTemplateGroup(t)
+		defer DeleteTemplateGroup(templateGroup, t)
+		groupID = templateGroup.GroupID
+	} else {
+		hostGroup := CreateHostGroup(t)
+		defer DeleteHostGroup(hostGroup, t)
+		groupID = hostGroup.GroupID
+	}
 
-	template := CreateTemplate(hostGroup, t)
+	template := CreateTemplate(groupID, t)
 	if template.TemplateID == "" {
 		t.Errorf("Template id is empty %#v", template)
 	}
@@ -0,0 +1,198 @@
+package zabbix
+
+import (
+	"strconv"
+	"time"
+)
+
+// Token represent Zabbix API token object, available since 5.4
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/token/object
+type Token struct {
+	TokenID     string     `json:"tokenid,omitempty"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	UserID      string     `json:"userid,omitempty"`
+	Status      StatusType `json:"status,string"`
+
+	// expiry is sent only if ExpiresAt is set, or as "never" if NeverExpires is true and ExpiresAt is zero,
+	// NeverExpires is set for tokens read back without expiry
+	RawExpiresAt string    `json:"expires_at,omitempty"`
+	ExpiresAt    time.Time `json:"-"`
+	NeverExpires bool      `json:"-"`
+
+	// read only
+	RawLastAccess string    `json:"lastaccess,omitempty"`
+	LastAccess    time.Time `json:"-"`
+	RawCreatedAt  string    `json:"created_at,omitempty"`
+	CreatedAt     time.Time `json:"-"`
+	CreatorUserID string    `json:"creator_userid,omitempty"`
+
+	// filled by TokensGenerate, the API never returns it again
+	Token string `json:"-"`
+}
+
+// Tokens is an array of Token
+type Tokens []Token
+
+// parseUnixTime converts unix timestamp returned by the API, zero is converted to zero time.
+func parseUnixTime(s string) time.Time {
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
+
+// formatUnixTime converts time to unix timestamp, zero time is converted to "0".
+func formatUnixTime(t time.Time) string {
+	if t.IsZero() {
+		return "0"
+	}
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+func tokensTimeUnmarshal(tokens Tokens) {
+	for i := range tokens {
+		tokens[i].ExpiresAt = parseUnixTime(tokens[i].RawExpiresAt)
+		tokens[i].NeverExpires = tokens[i].ExpiresAt.IsZero()
+		tokens[i].LastAccess = parseUnixTime(tokens[i].RawLastAccess)
+		tokens[i].CreatedAt = parseUnixTime(tokens[i].RawCreatedAt)
+	}
+}
+
+// prepTokens returns copies of the tokens without read only fields.
+func prepTokens(tokens Tokens) Tokens {
+	res := make(Tokens, len(tokens))
+	for i, token := range tokens {
+		token.RawExpiresAt = ""
+		if !token.ExpiresAt.IsZero() || token.NeverExpires {
+			token.RawExpiresAt = formatUnixTime(token.ExpiresAt)
+		}
+		token.RawLastAccess = ""
+		token.RawCreatedAt = ""
+		token.CreatorUserID = ""
+		res[i] = token
+	}
+	return res
+}
+
+// TokensGet Wrapper for token.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/token/get
+func (api *API) TokensGet(params Params) (res Tokens, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("token.get", params, &res)
+	tokensTimeUnmarshal(res)
+	return
+}
+
+// TokenGetByID Gets token by Id only if there is exactly 1 matching token.
+func (api *API) TokenGetByID(id string) (res *Token, err error) {
+	tokens, err := api.TokensGet(Params{"tokenids": id})
+	if err != nil {
+		return
+	}
+
+	if len(tokens) == 1 {
+		res = &tokens[0]
+	} else {
+		e := ExpectedOneResult(len(tokens))
+		err = &e
+	}
+	return
+}
+
+// TokensCreate Wrapper for token.create
+// The token string is not generated yet, call TokensGenerate to get it.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/token/create
+func (api *API) TokensCreate(tokens Tokens) (err error) {
+	response, err := api.CallWithError("token.create", prepTokens(tokens))
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	tokenids := result["tokenids"].([]interface{})
+	for i, id := range tokenids {
+		tokens[i].TokenID = id.(string)
+	}
+	return
+}
+
+// TokensUpdate Wrapper for token.update
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/token/update
+func (api *API) TokensUpdate(tokens Tokens) (err error) {
+	update := prepTokens(tokens)
+	for i := range update {
+		// owner of a token can't be changed
+		update[i].UserID = ""
+	}
+	_, err = api.CallWithError("token.update", update)
+	return
+}
+
+// TokensGenerate Wrapper for token.generate
+// Generates new token strings, invalidating previous ones, and fills Token in all tokens elements.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/token/generate
+func (api *API) TokensGenerate(tokens Tokens) (err error) {
+	ids := make([]string, len(tokens))
+	for i, token := range tokens {
+		ids[i] = token.TokenID
+	}
+
+	var generated []struct {
+		TokenID string `json:"tokenid"`
+		Token   string `json:"token"`
+	}
+	err = api.CallWithErrorParse("token.generate", ids, &generated)
+	if err != nil {
+		return
+	}
+
+	byID := make(map[string]string, len(generated))
+	for _, g := range generated {
+		byID[g.TokenID] = g.Token
+	}
+	for i := range tokens {
+		tokens[i].Token = byID[tokens[i].TokenID]
+	}
+	if len(ids) != len(generated) {
+		err = &ExpectedMore{len(ids), len(generated)}
+	}
+	return
+}
+
+// TokensDelete Wrapper for token.delete
+// Cleans TokenID in all tokens elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/token/delete
+func (api *API) TokensDelete(tokens Tokens) (err error) {
+	ids := make([]string, len(tokens))
+	for i, token := range tokens {
+		ids[i] = token.TokenID
+	}
+
+	err = api.TokensDeleteByIds(ids)
+	if err == nil {
+		for i := range tokens {
+			tokens[i].TokenID = ""
+		}
+	}
+	return
+}
+
+// TokensDeleteByIds Wrapper for token.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/token/delete
+func (api *API) TokensDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("token.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	tokenids := result["tokenids"].([]interface{})
+	if len(ids) != len(tokenids) {
+		err = &ExpectedMore{len(ids), len(tokenids)}
+	}
+	return
+}
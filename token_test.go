@@ -0,0 +1,75 @@
+package zabbix_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestTokens(t *testing.T) {
+	api := getAPI(t)
+
+	expires := time.Now().Add(time.Hour).Truncate(time.Second)
+	tokens := zapi.Tokens{{
+		Name:      fmt.Sprintf("zabbix-testing-%d", rand.Int()),
+		ExpiresAt: expires,
+	}}
+	err := api.TokensCreate(tokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokens[0].TokenID == "" {
+		t.Errorf("Id is empty: %#v", tokens[0])
+	}
+
+	err = api.TokensGenerate(tokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokens[0].Token == "" {
+		t.Errorf("Token is empty: %#v", tokens[0])
+	}
+
+	token, err := api.TokenGetByID(tokens[0].TokenID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !token.ExpiresAt.Equal(expires) {
+		t.Errorf("Expected expiry %s, got %s", expires, token.ExpiresAt)
+	}
+
+	// status only update keeps the expiry
+	err = api.TokensUpdate(zapi.Tokens{{TokenID: token.TokenID, Name: token.Name, Status: zapi.Disabled}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err = api.TokenGetByID(tokens[0].TokenID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.Status != zapi.Disabled || !token.ExpiresAt.Equal(expires) || token.NeverExpires {
+		t.Errorf("Bad token: %#v", token)
+	}
+
+	token.ExpiresAt = time.Time{}
+	token.NeverExpires = true
+	err = api.TokensUpdate(zapi.Tokens{*token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err = api.TokenGetByID(tokens[0].TokenID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !token.ExpiresAt.IsZero() || !token.NeverExpires {
+		t.Errorf("Expiry is not cleared: %#v", token)
+	}
+
+	err = api.TokensDelete(tokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,5 +1,104 @@
 package zabbix
 
+import "encoding/json"
+
+// SeverityMask bitmask of trigger severities a media is used for
+// see "severity" in https://www.zabbix.com/documentation/current/en/manual/api/reference/user/object#media
+type SeverityMask int
+
+// SeverityMaskAll sends notifications for every severity
+const SeverityMaskAll SeverityMask = 63
+
+// NewSeverityMask builds a mask from the given severities.
+func NewSeverityMask(severities ...SeverityType) (mask SeverityMask) {
+	for _, s := range severities {
+		mask |= 1 << uint(s)
+	}
+	return
+}
+
+// Has reports whether the mask includes the severity.
+func (m SeverityMask) Has(s SeverityType) bool {
+	return m&(1<<uint(s)) != 0
+}
+
+// MediaSendTo recipients of a user media.
+// Email media types use an array, all other types a single string, see Media.SendToString.
+type MediaSendTo []string
+
+// MarshalJSON sends recipients as array.
+func (s MediaSendTo) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]string(s))
+}
+
+// UnmarshalJSON accepts both a string and an array of strings.
+func (s *MediaSendTo) UnmarshalJSON(b []byte) error {
+	var one string
+	if err := json.Unmarshal(b, &one); err == nil {
+		*s = MediaSendTo{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(b, &many); err != nil {
+		return err
+	}
+	*s = MediaSendTo(many)
+	return nil
+}
+
+// Media represent Zabbix user media object
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/user/object#media
+type Media struct {
+	MediaID     string       `json:"mediaid,omitempty"`
+	MediaTypeID string       `json:"mediatypeid"`
+	SendTo      MediaSendTo  `json:"sendto"`
+	Active      StatusType   `json:"active,string"`
+	Severity    SeverityMask `json:"severity,string"`
+	// Period when notifications can be sent, e.g. "1-7,00:00-24:00"
+	Period string `json:"period,omitempty"`
+
+	// SendToString sends the recipient as string, required by all but email media types.
+	// Set when the media is read with a string recipient.
+	SendToString bool `json:"-"`
+}
+
+// media is Media without custom marshalling
+type media Media
+
+// MarshalJSON sends the recipient as string if SendToString is set.
+func (m Media) MarshalJSON() ([]byte, error) {
+	if !m.SendToString || len(m.SendTo) != 1 {
+		return json.Marshal(media(m))
+	}
+	return json.Marshal(struct {
+		media
+		SendTo string `json:"sendto"`
+	}{media(m), m.SendTo[0]})
+}
+
+// UnmarshalJSON remembers whether the recipient was read as string.
+func (m *Media) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		media
+		RawSendTo json.RawMessage `json:"sendto"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*m = Media(raw.media)
+	if len(raw.RawSendTo) == 0 {
+		return nil
+	}
+	m.SendToString = raw.RawSendTo[0] == '"'
+	return json.Unmarshal(raw.RawSendTo, &m.SendTo)
+}
+
+// Medias is an array of Media
+type Medias []Media
+
 // User represent Zabbix user object
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/user/object
 type User struct {
@@ -10,6 +109,7 @@ type User struct {
 	Name     string       `json:"name"`
 	Surname  string       `json:"surname"`
 	Groups   usergroupids `json:"usrgrps"`
+	Medias   Medias       `json:"medias,omitempty"`
 }
 
 // Users is an array of User
@@ -35,7 +135,7 @@ func (api *API) UsersGet(params Params) (res Users, err error) {
 
 // UserGetByID Gets user by Id only if there is exactly 1 matching user.
 func (api *API) UserGetByID(id string) (res *User, err error) {
-	groups, err := api.UsersGet(Params{"userids": id})
+	groups, err := api.UsersGet(Params{"userids": id, "selectMedias": "extend"})
 	if err != nil {
 		return
 	}
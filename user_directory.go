@@ -0,0 +1,215 @@
+package zabbix
+
+import "encoding/json"
+
+// UserDirectoryIdpType type of the identity provider
+// see "idp_type" in https://www.zabbix.com/documentation/current/en/manual/api/reference/userdirectory/object
+type UserDirectoryIdpType int
+
+const (
+	UserDirectoryLDAP UserDirectoryIdpType = 1
+	// UserDirectorySAML since 6.4, there is only one SAML directory
+	UserDirectorySAML UserDirectoryIdpType = 2
+)
+
+// UserDirectoryProvisionMedia maps a directory attribute to a user media, since 6.4
+type UserDirectoryProvisionMedia struct {
+	// UserDirectoryMediaID read only, since 7.0
+	UserDirectoryMediaID string `json:"userdirectory_mediaid,omitempty"`
+	Name                 string `json:"name"`
+	MediaTypeID          string `json:"mediatypeid"`
+	Attribute            string `json:"attribute"`
+
+	// since 7.0, defaults of the provisioned media
+	Active   string       `json:"active,omitempty"`
+	Severity SeverityMask `json:"severity,omitempty,string"`
+	Period   string       `json:"period,omitempty"`
+}
+
+// UserDirectoryProvisionGroup maps a directory group to a role and user groups, since 6.4
+type UserDirectoryProvisionGroup struct {
+	// Name of the directory group, may contain wildcards
+	Name       string       `json:"name"`
+	RoleID     string       `json:"roleid"`
+	UserGroups usergroupids `json:"user_groups"`
+}
+
+// UserDirectory represent Zabbix user directory object, available since 6.2 (LDAP only before 6.4)
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/userdirectory/object
+type UserDirectory struct {
+	UserDirectoryID string               `json:"userdirectoryid,omitempty"`
+	Name            string               `json:"name,omitempty"`
+	IdpType         UserDirectoryIdpType `json:"idp_type,omitempty,string"`
+	Description     string               `json:"description,omitempty"`
+	ProvisionStatus string               `json:"provision_status,omitempty"`
+
+	// LDAP fields
+	Host            string `json:"host,omitempty"`
+	Port            string `json:"port,omitempty"`
+	BaseDN          string `json:"base_dn,omitempty"`
+	SearchAttribute string `json:"search_attribute,omitempty"`
+	BindDN          string `json:"bind_dn,omitempty"`
+	// BindPassword write only
+	BindPassword    string `json:"bind_password,omitempty"`
+	SearchFilter    string `json:"search_filter,omitempty"`
+	StartTLS        string `json:"start_tls,omitempty"`
+	GroupBaseDN     string `json:"group_basedn,omitempty"`
+	GroupMember     string `json:"group_member,omitempty"`
+	UserRefAttr     string `json:"user_ref_attr,omitempty"`
+	GroupFilter     string `json:"group_filter,omitempty"`
+	GroupMembership string `json:"group_membership,omitempty"`
+
+	// SAML fields
+	IdpEntityID         string `json:"idp_entityid,omitempty"`
+	SSOURL              string `json:"sso_url,omitempty"`
+	SLOURL              string `json:"slo_url,omitempty"`
+	UsernameAttribute   string `json:"username_attribute,omitempty"`
+	SPEntityID          string `json:"sp_entityid,omitempty"`
+	NameIDFormat        string `json:"nameid_format,omitempty"`
+	SignMessages        string `json:"sign_messages,omitempty"`
+	SignAssertions      string `json:"sign_assertions,omitempty"`
+	SignAuthnRequests   string `json:"sign_authn_requests,omitempty"`
+	SignLogoutRequests  string `json:"sign_logout_requests,omitempty"`
+	SignLogoutResponses string `json:"sign_logout_responses,omitempty"`
+	EncryptNameID       string `json:"encrypt_nameid,omitempty"`
+	EncryptAssertions   string `json:"encrypt_assertions,omitempty"`
+	SCIMStatus          string `json:"scim_status,omitempty"`
+
+	// provisioning attributes, LDAP and SAML
+	GroupName    string `json:"group_name,omitempty"`
+	UserUsername string `json:"user_username,omitempty"`
+	UserLastname string `json:"user_lastname,omitempty"`
+
+	ProvisionMedia  []UserDirectoryProvisionMedia `json:"provision_media,omitempty"`
+	ProvisionGroups []UserDirectoryProvisionGroup `json:"provision_groups,omitempty"`
+}
+
+// UserDirectories is an array of UserDirectory
+type UserDirectories []UserDirectory
+
+// UserDirectoryTestResult user data provisioned by userdirectory.test, since 6.4
+type UserDirectoryTestResult struct {
+	Username   string       `json:"username"`
+	Name       string       `json:"name"`
+	Surname    string       `json:"surname"`
+	RoleID     string       `json:"roleid"`
+	UserGroups usergroupids `json:"usrgrps"`
+	Medias     Medias       `json:"medias"`
+}
+
+// UserDirectoriesGet Wrapper for userdirectory.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/userdirectory/get
+func (api *API) UserDirectoriesGet(params Params) (res UserDirectories, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParse("userdirectory.get", params, &res)
+	return
+}
+
+// UserDirectoryGetByID Gets user directory by Id only if there is exactly 1 matching directory.
+// Provisioning mappings are selected since 6.4.
+func (api *API) UserDirectoryGetByID(id string) (res *UserDirectory, err error) {
+	params := Params{"userdirectoryids": id}
+	if api.Config.Version >= 60400 {
+		params["selectProvisionMedia"] = "extend"
+		params["selectProvisionGroups"] = "extend"
+	}
+	directories, err := api.UserDirectoriesGet(params)
+	if err != nil {
+		return
+	}
+
+	if len(directories) == 1 {
+		res = &directories[0]
+	} else {
+		e := ExpectedOneResult(len(directories))
+		err = &e
+	}
+	return
+}
+
+// UserDirectoriesCreate Wrapper for userdirectory.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/userdirectory/create
+func (api *API) UserDirectoriesCreate(directories UserDirectories) (err error) {
+	response, err := api.CallWithError("userdirectory.create", directories)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	userdirectoryids := result["userdirectoryids"].([]interface{})
+	for i, id := range userdirectoryids {
+		directories[i].UserDirectoryID = id.(string)
+	}
+	return
+}
+
+// UserDirectoriesUpdate Wrapper for userdirectory.update
+// Identity provider type can't be changed and is not sent.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/userdirectory/update
+func (api *API) UserDirectoriesUpdate(directories UserDirectories) (err error) {
+	update := make(UserDirectories, len(directories))
+	for i, directory := range directories {
+		directory.IdpType = 0
+		update[i] = directory
+	}
+	_, err = api.CallWithError("userdirectory.update", update)
+	return
+}
+
+// UserDirectoriesDelete Wrapper for userdirectory.delete
+// Cleans UserDirectoryID in all directories elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/userdirectory/delete
+func (api *API) UserDirectoriesDelete(directories UserDirectories) (err error) {
+	ids := make([]string, len(directories))
+	for i, directory := range directories {
+		ids[i] = directory.UserDirectoryID
+	}
+
+	err = api.UserDirectoriesDeleteByIds(ids)
+	if err == nil {
+		for i := range directories {
+			directories[i].UserDirectoryID = ""
+		}
+	}
+	return
+}
+
+// UserDirectoriesDeleteByIds Wrapper for userdirectory.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/userdirectory/delete
+func (api *API) UserDirectoriesDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("userdirectory.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	userdirectoryids := result["userdirectoryids"].([]interface{})
+	if len(ids) != len(userdirectoryids) {
+		err = &ExpectedMore{len(ids), len(userdirectoryids)}
+	}
+	return
+}
+
+// UserDirectoryTest Wrapper for userdirectory.test
+// Tests LDAP directory settings by authenticating the user. Provisioned user data is returned
+// when provisioning is enabled since 6.4, res is nil otherwise.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/userdirectory/test
+func (api *API) UserDirectoryTest(directory UserDirectory, username, password string) (res *UserDirectoryTestResult, err error) {
+	params, err := objectParams(directory)
+	if err != nil {
+		return
+	}
+	params["test_username"] = username
+	params["test_password"] = password
+
+	var raw json.RawMessage
+	err = api.CallWithErrorParse("userdirectory.test", params, &raw)
+	if err != nil || len(raw) == 0 || raw[0] != '{' {
+		return
+	}
+	res = &UserDirectoryTestResult{}
+	err = json.Unmarshal(raw, res)
+	return
+}
@@ -0,0 +1,69 @@
+package zabbix_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestUserDirectories(t *testing.T) {
+	api := getAPI(t)
+	if api.Config.Version < 60200 {
+		t.Skip("User directories are available since 6.2")
+	}
+
+	directories := zapi.UserDirectories{{
+		Name:            fmt.Sprintf("zabbix-testing-%d", rand.Int()),
+		Host:            "ldap://ldap.example.com",
+		Port:            "389",
+		BaseDN:          "ou=Users,dc=example,dc=com",
+		SearchAttribute: "uid",
+		BindPassword:    "secret",
+	}}
+	if api.Config.Version >= 60400 {
+		directories[0].IdpType = zapi.UserDirectoryLDAP
+	}
+	err := api.UserDirectoriesCreate(directories)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if directories[0].UserDirectoryID == "" {
+		t.Fatalf("Id is empty: %#v", directories[0])
+	}
+
+	directory, err := api.UserDirectoryGetByID(directories[0].UserDirectoryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if directory.Name != directories[0].Name || directory.Host != "ldap://ldap.example.com" || directory.SearchAttribute != "uid" {
+		t.Errorf("Bad directory: %#v", directory)
+	}
+	if directory.BindPassword != "" {
+		t.Errorf("Bind password is returned: %#v", directory)
+	}
+
+	// idp type can't be updated and is not sent
+	directory.Description = "updated"
+	err = api.UserDirectoriesUpdate(zapi.UserDirectories{*directory})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	directory, err = api.UserDirectoryGetByID(directories[0].UserDirectoryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if directory.Description != "updated" {
+		t.Errorf("Directory is not updated: %#v", directory)
+	}
+
+	err = api.UserDirectoriesDelete(directories)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if directories[0].UserDirectoryID != "" {
+		t.Errorf("Id is not cleaned: %#v", directories[0])
+	}
+}
@@ -3,12 +3,24 @@ package zabbix
 // UserGroupGroup represent Zabbix usergroup object
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/usergroup/object
 type UserGroup struct {
-	UserGroupID string               `json:"usrgrpid,omitempty"`
-	Name        string               `json:"name"`
-	DebugMode   int                  `json:"debug_mode,string"`
-	GUIAccess   int                  `json:"gui_access,string"`
-	Status      int                  `json:"users_status,string"`
+	UserGroupID string `json:"usrgrpid,omitempty"`
+	Name        string `json:"name"`
+	DebugMode   int    `json:"debug_mode,string"`
+	GUIAccess   int    `json:"gui_access,string"`
+	Status      int    `json:"users_status,string"`
+	// Permissions to host groups, before 6.2 also to the groups of templates
 	Permissions usergrouppermissions `json:"hostgroup_rights,omitempty"`
+	// TemplatePermissions to template groups, since 6.2
+	TemplatePermissions usergrouppermissions `json:"templategroup_rights,omitempty"`
+	// permissions are sent and read back from this one before 6.2
+	RawRights usergrouppermissions `json:"rights,omitempty"`
+
+	// UserDirectoryID LDAP or SAML directory authenticating members, since 6.2
+	UserDirectoryID string `json:"userdirectoryid,omitempty"`
+	// MFAStatus "0" disabled or "1" enabled, since 7.0
+	MFAStatus string `json:"mfa_status,omitempty"`
+	// MFAID method used by members, the default one when empty, since 7.0
+	MFAID string `json:"mfaid,omitempty"`
 }
 
 // UserGroups is an array of UserGroup
@@ -26,7 +38,7 @@ type usergroupids []UserGroupID
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/usergroup/object
 type UserGroupPermission struct {
 	ID         string `json:"id"`
-	Permission int    `json:"permission"`
+	Permission int    `json:"permission,string"`
 }
 
 // usergrouppermissions is an array of UserGroupPermission
@@ -39,12 +51,43 @@ func (api *API) UserGroupsGet(params Params) (res UserGroups, err error) {
 		params["output"] = "extend"
 	}
 	err = api.CallWithErrorParse("usergroup.get", params, &res)
+	for i := range res {
+		if res[i].RawRights != nil {
+			res[i].Permissions = res[i].RawRights
+			res[i].RawRights = nil
+		}
+	}
 	return
 }
 
+// userGroupRightsParams returns parameters selecting permissions of user groups for the server version.
+func (api *API) userGroupRightsParams(params Params) Params {
+	if api.Config.Version >= 60200 {
+		params["selectHostGroupRights"] = "extend"
+		params["selectTemplateGroupRights"] = "extend"
+	} else {
+		params["selectRights"] = "extend"
+	}
+	return params
+}
+
+// prepUserGroups returns copies of the user groups with permissions in the fields of the server version.
+func (api *API) prepUserGroups(userGroups UserGroups) UserGroups {
+	res := make(UserGroups, len(userGroups))
+	for i, userGroup := range userGroups {
+		if api.Config.Version < 60200 && (userGroup.Permissions != nil || userGroup.TemplatePermissions != nil) {
+			userGroup.RawRights = append(append(usergrouppermissions{}, userGroup.Permissions...), userGroup.TemplatePermissions...)
+			userGroup.Permissions = nil
+			userGroup.TemplatePermissions = nil
+		}
+		res[i] = userGroup
+	}
+	return res
+}
+
 // UserGroupGetByID Gets usergroup by Id only if there is exactly 1 matching usergroup.
 func (api *API) UserGroupGetByID(id string) (res *UserGroup, err error) {
-	groups, err := api.UserGroupsGet(Params{"usrgrpids": id})
+	groups, err := api.UserGroupsGet(api.userGroupRightsParams(Params{"usrgrpids": id}))
 	if err != nil {
 		return
 	}
@@ -61,7 +104,7 @@ func (api *API) UserGroupGetByID(id string) (res *UserGroup, err error) {
 // UserGroupsCreate Wrapper for usergroup.create
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/usergroup/create
 func (api *API) UserGroupsCreate(UserGroups UserGroups) (err error) {
-	response, err := api.CallWithError("usergroup.create", UserGroups)
+	response, err := api.CallWithError("usergroup.create", api.prepUserGroups(UserGroups))
 	if err != nil {
 		return
 	}
@@ -77,7 +120,7 @@ func (api *API) UserGroupsCreate(UserGroups UserGroups) (err error) {
 // UserGroupsUpdate Wrapper for usergroup.update
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/usergroup/update
 func (api *API) UserGroupsUpdate(UserGroups UserGroups) (err error) {
-	_, err = api.CallWithError("usergroup.update", UserGroups)
+	_, err = api.CallWithError("usergroup.update", api.prepUserGroups(UserGroups))
 	return
 }
 
@@ -0,0 +1,142 @@
+package zabbix
+
+type (
+	// ValueMappingType how a mapping matches the value
+	// see "type" in https://www.zabbix.com/documentation/current/en/manual/api/reference/valuemap/object#value-mappings
+	ValueMappingType int
+)
+
+const (
+	// MappingExact value equals (default)
+	MappingExact ValueMappingType = 0
+	// MappingGreaterOrEqual value is greater or equal
+	MappingGreaterOrEqual ValueMappingType = 1
+	// MappingLessOrEqual value is less or equal
+	MappingLessOrEqual ValueMappingType = 2
+	// MappingRange value is in range, e.g. "1-10,20"
+	MappingRange ValueMappingType = 3
+	// MappingRegexp value matches regular expression
+	MappingRegexp ValueMappingType = 4
+	// MappingDefault default value, used when no other mapping matches
+	MappingDefault ValueMappingType = 5
+)
+
+// ValueMapping represents a single mapping of a value map
+type ValueMapping struct {
+	Type     ValueMappingType `json:"type,string"`
+	Value    string           `json:"value"`
+	NewValue string           `json:"newvalue"`
+}
+
+// ValueMappings is an array of ValueMapping
+type ValueMappings []ValueMapping
+
+// ValueMap represent Zabbix value map object, scoped to a host or template since 5.4
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/valuemap/object
+type ValueMap struct {
+	ValueMapID string        `json:"valuemapid,omitempty"`
+	HostID     string        `json:"hostid,omitempty"`
+	Name       string        `json:"name"`
+	Mappings   ValueMappings `json:"mappings"`
+	UUID       string        `json:"uuid,omitempty"`
+}
+
+// ValueMaps is an array of ValueMap
+type ValueMaps []ValueMap
+
+// ValueMapsGet Wrapper for valuemap.get
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/valuemap/get
+func (api *API) ValueMapsGet(params Params) (res ValueMaps, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	if _, present := params["selectMappings"]; !present {
+		params["selectMappings"] = "extend"
+	}
+	err = api.CallWithErrorParse("valuemap.get", params, &res)
+	return
+}
+
+// ValueMapsGetByHostID Gets value maps of the host or template.
+func (api *API) ValueMapsGetByHostID(id string) (res ValueMaps, err error) {
+	return api.ValueMapsGet(Params{"hostids": id})
+}
+
+// ValueMapGetByID Gets value map by Id only if there is exactly 1 matching value map.
+func (api *API) ValueMapGetByID(id string) (res *ValueMap, err error) {
+	valueMaps, err := api.ValueMapsGet(Params{"valuemapids": id})
+	if err != nil {
+		return
+	}
+
+	if len(valueMaps) == 1 {
+		res = &valueMaps[0]
+	} else {
+		e := ExpectedOneResult(len(valueMaps))
+		err = &e
+	}
+	return
+}
+
+// ValueMapsCreate Wrapper for valuemap.create
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/valuemap/create
+func (api *API) ValueMapsCreate(valueMaps ValueMaps) (err error) {
+	response, err := api.CallWithError("valuemap.create", valueMaps)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	valuemapids := result["valuemapids"].([]interface{})
+	for i, id := range valuemapids {
+		valueMaps[i].ValueMapID = id.(string)
+	}
+	return
+}
+
+// ValueMapsUpdate Wrapper for valuemap.update
+// The host of a value map can't be changed, HostID is not sent.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/valuemap/update
+func (api *API) ValueMapsUpdate(valueMaps ValueMaps) (err error) {
+	update := make(ValueMaps, len(valueMaps))
+	for i, valueMap := range valueMaps {
+		valueMap.HostID = ""
+		update[i] = valueMap
+	}
+	_, err = api.CallWithError("valuemap.update", update)
+	return
+}
+
+// ValueMapsDelete Wrapper for valuemap.delete
+// Cleans ValueMapID in all valueMaps elements if call succeed.
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/valuemap/delete
+func (api *API) ValueMapsDelete(valueMaps ValueMaps) (err error) {
+	ids := make([]string, len(valueMaps))
+	for i, valueMap := range valueMaps {
+		ids[i] = valueMap.ValueMapID
+	}
+
+	err = api.ValueMapsDeleteByIds(ids)
+	if err == nil {
+		for i := range valueMaps {
+			valueMaps[i].ValueMapID = ""
+		}
+	}
+	return
+}
+
+// ValueMapsDeleteByIds Wrapper for valuemap.delete
+// https://www.zabbix.com/documentation/current/en/manual/api/reference/valuemap/delete
+func (api *API) ValueMapsDeleteByIds(ids []string) (err error) {
+	response, err := api.CallWithError("valuemap.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	valuemapids := result["valuemapids"].([]interface{})
+	if len(ids) != len(valuemapids) {
+		err = &ExpectedMore{len(ids), len(valuemapids)}
+	}
+	return
+}
@@ -0,0 +1,75 @@
+package zabbix_test
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	zapi "github.com/lavrenko/go-zabbix-api"
+)
+
+func TestValueMaps(t *testing.T) {
+	api := getAPI(t)
+	if api.Config.Version < 50400 {
+		t.Skip("Value maps belong to hosts since 5.4")
+	}
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	host := CreateHost(group, t)
+	defer DeleteHost(host, t)
+
+	valueMaps := zapi.ValueMaps{{
+		HostID: host.HostID,
+		Name:   fmt.Sprintf("zabbix-testing-%d", rand.Int()),
+		Mappings: zapi.ValueMappings{
+			{Type: zapi.MappingExact, Value: "0", NewValue: "Down"},
+			{Type: zapi.MappingRange, Value: "1-10", NewValue: "Up"},
+			{Type: zapi.MappingDefault, NewValue: "Unknown"},
+		},
+	}}
+	err := api.ValueMapsCreate(valueMaps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valueMap := &valueMaps[0]
+	if valueMap.ValueMapID == "" {
+		t.Fatalf("Id is empty: %#v", valueMap)
+	}
+
+	valueMap2, err := api.ValueMapGetByID(valueMap.ValueMapID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valueMap2.UUID = ""
+	if !reflect.DeepEqual(valueMap, valueMap2) {
+		t.Errorf("Error getting value map.\nOld value map: %#v\nNew value map: %#v", valueMap, valueMap2)
+	}
+
+	valueMap.Mappings = zapi.ValueMappings{{Type: zapi.MappingExact, Value: "1", NewValue: "Up"}}
+	err = api.ValueMapsUpdate(valueMaps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valueMap.HostID != host.HostID {
+		t.Errorf("HostID is changed: %#v", valueMap)
+	}
+
+	res, err := api.ValueMapsGetByHostID(host.HostID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || !reflect.DeepEqual(res[0].Mappings, valueMap.Mappings) {
+		t.Errorf("Value map is not updated: %#v", res)
+	}
+
+	err = api.ValueMapsDelete(valueMaps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valueMap.ValueMapID != "" {
+		t.Errorf("Id is not cleaned: %#v", valueMap)
+	}
+}